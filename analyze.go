@@ -0,0 +1,78 @@
+package ischinese
+
+import "unicode/utf8"
+
+// Report per rune analysis of a string, see Analyze
+type Report struct {
+	// Total number of unicode code points
+	Total int
+	// Chinese number of Chinese unicode code points
+	Chinese int
+	// SimplifiedOnly number of Chinese unicode code points which are simplified only
+	SimplifiedOnly int
+	// TraditionalOnly number of Chinese unicode code points which are traditional only
+	TraditionalOnly int
+	// NonChinese unicode code points which are not Chinese unicode, in order, nil if there are none and for StreamAnalyzer
+	NonChinese []RuneOffset
+}
+
+// RuneOffset unicode code point and its byte offset in the string
+type RuneOffset struct {
+	Rune   rune
+	Offset int
+}
+
+// Analyze count the unicode code points of s by classification and collect the non Chinese ones with their byte offsets.
+// Characters valid in both scripts count toward neither SimplifiedOnly nor TraditionalOnly.
+func Analyze(s string) Report {
+	return Default().Analyze(s)
+}
+
+// add count a unicode code point of class, false if it is not Chinese unicode
+func (report *Report) add(class RuneClass) bool {
+	report.Total++
+	if class == RuneNonChinese {
+		return false
+	}
+	report.Chinese++
+	switch class {
+	case RuneSimplified:
+		report.SimplifiedOnly++
+	case RuneTraditional:
+		report.TraditionalOnly++
+	}
+	return true
+}
+
+// StreamAnalyzer io.Writer accumulating the counts of the Report of all bytes written, see Analyze.
+// NonChinese is left nil, so memory stays flat regardless of input size.
+// A UTF-8 sequence split across Write calls is buffered until complete, the zero value is ready to use.
+type StreamAnalyzer struct {
+	report Report
+	// incomplete trailing UTF-8 sequence of the last Write
+	pending []byte
+}
+
+// Write analyze p, it never returns an error
+func (a *StreamAnalyzer) Write(p []byte) (int, error) {
+	data := p
+	if len(a.pending) > 0 {
+		data = append(a.pending, p...)
+	}
+	for len(data) > 0 && utf8.FullRune(data) {
+		r, size := utf8.DecodeRune(data)
+		a.report.add(classifyRune(r))
+		data = data[size:]
+	}
+	a.pending = append(a.pending[:0], data...)
+	return len(p), nil
+}
+
+// Result return the counts of all bytes written so far, an incomplete trailing UTF-8 sequence counts as invalid bytes like in Analyze
+func (a *StreamAnalyzer) Result() Report {
+	report := a.report
+	for range a.pending {
+		report.add(classifyRune(utf8.RuneError))
+	}
+	return report
+}
@@ -0,0 +1,120 @@
+package ischinese
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestAnalyze(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want Report
+	}{
+		{
+			s:    "",
+			want: Report{},
+		},
+		{
+			s: "中文",
+			want: Report{
+				Total:   2,
+				Chinese: 2,
+			},
+		},
+		{
+			s: "a国b國 c人",
+			want: Report{
+				Total:           7,
+				Chinese:         3,
+				SimplifiedOnly:  1,
+				TraditionalOnly: 1,
+				NonChinese: []RuneOffset{
+					{Rune: 'a', Offset: 0},
+					{Rune: 'b', Offset: 4},
+					{Rune: ' ', Offset: 8},
+					{Rune: 'c', Offset: 9},
+				},
+			},
+		},
+		{
+			s: "中文かな",
+			want: Report{
+				Total:   4,
+				Chinese: 2,
+				NonChinese: []RuneOffset{
+					{Rune: 'か', Offset: 6},
+					{Rune: 'な', Offset: 9},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Analyze(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Analyze() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamAnalyzer(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "a国b國 c人",
+		},
+		{
+			s: "中文かな𠀀abc，。",
+		},
+		{
+			s: "invalid \xe4\xbd utf-8 中文",
+		},
+		{
+			s: "truncated 中\xe4\xbd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := Analyze(tt.s)
+			want.NonChinese = nil
+			for size := 1; size <= len(tt.s)+1; size++ {
+				var a StreamAnalyzer
+				for i := 0; i < len(tt.s); i += size {
+					end := i + size
+					if end > len(tt.s) {
+						end = len(tt.s)
+					}
+					if _, err := a.Write([]byte(tt.s[i:end])); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if got := a.Result(); !reflect.DeepEqual(got, want) {
+					t.Errorf("Result() = %v, want %v, writing %d bytes at a time", got, want, size)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamAnalyzerCopy(t *testing.T) {
+	s := "在军队中，汤和算是个奇特的人 hello"
+	var a StreamAnalyzer
+	if _, err := io.Copy(&a, iotest.OneByteReader(strings.NewReader(s))); err != nil {
+		t.Fatal(err)
+	}
+	want := Analyze(s)
+	want.NonChinese = nil
+	if got := a.Result(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Result() = %v, want %v", got, want)
+	}
+}
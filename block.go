@@ -0,0 +1,29 @@
+package ischinese
+
+// DominantIdeographBlock return the name of the unicode block contributing most CJK ideographs, e.g. "CJK Unified Ideographs Extension B",
+// which tells whether text needs fonts beyond the main block. Ties go to the block listed first in ideographBlocks,
+// return "" if s has no ideographs.
+func DominantIdeographBlock(s string) string {
+	counts := make([]int, len(ideographBlocks))
+	for _, r := range s {
+		if !isIdeographChar(r) {
+			continue
+		}
+		for i, block := range ideographBlocks {
+			if block.lo <= r && r <= block.hi {
+				counts[i]++
+				break
+			}
+		}
+	}
+	best := -1
+	for i, count := range counts {
+		if count > 0 && (best < 0 || count > counts[best]) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return ideographBlocks[best].name
+}
@@ -0,0 +1,45 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestDominantIdeographBlock(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "hello，。",
+			want: "",
+		},
+		{
+			s:    "你好\U00020000",
+			want: "CJK Unified Ideographs",
+		},
+		{
+			s:    "\U00020000\U00020001你",
+			want: "CJK Unified Ideographs Extension B",
+		},
+		{
+			s:    "㐀你",
+			want: "CJK Unified Ideographs",
+		},
+		{
+			s:    "\uF900\uF901",
+			want: "CJK Compatibility Ideographs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DominantIdeographBlock(tt.s); got != tt.want {
+				t.Errorf("DominantIdeographBlock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package ischinese
+
+import "unicode/utf8"
+
+// IsChineseBytes true if more than 50% of unicode code points in UTF-8 encoded b are Chinese unicode, same as IsChinese(string(b))
+// without copying b. Invalid UTF-8 bytes count as non Chinese unicode code points.
+func IsChineseBytes(b []byte) bool {
+	return Default().IsChineseBytes(b)
+}
+
+// IsSimplifiedChineseBytes true if more than 50% of unicode code points in UTF-8 encoded b are simplified Chinese unicode
+func IsSimplifiedChineseBytes(b []byte) bool {
+	return Default().IsSimplifiedChineseBytes(b)
+}
+
+// IsTraditionalChineseBytes true if more than 50% of unicode code points in UTF-8 encoded b are traditional Chinese unicode
+func IsTraditionalChineseBytes(b []byte) bool {
+	return Default().IsTraditionalChineseBytes(b)
+}
+
+func nonPureBytesHelper(b []byte, f func(rune) bool, threshold float64) bool {
+	c := runeCounter{f: f}
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		c.add(r)
+	}
+	return c.mostly(threshold)
+}
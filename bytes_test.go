@@ -0,0 +1,67 @@
+package ischinese
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsChineseBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello world",
+		},
+		{
+			s: "中文 abc",
+		},
+		{
+			s: "你很機車哎",
+		},
+		{
+			s: "【厉害的陈友谅】",
+		},
+		{
+			s: "国国國a",
+		},
+		{
+			s: "中文\xe4\xbd\xff",
+		},
+		{
+			s: "中\xff",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := IsChineseBytes([]byte(tt.s)), IsChinese(tt.s); got != want {
+				t.Errorf("IsChineseBytes() = %v, want %v", got, want)
+			}
+			if got, want := IsSimplifiedChineseBytes([]byte(tt.s)), IsSimplifiedChinese(tt.s); got != want {
+				t.Errorf("IsSimplifiedChineseBytes() = %v, want %v", got, want)
+			}
+			if got, want := IsTraditionalChineseBytes([]byte(tt.s)), IsTraditionalChinese(tt.s); got != want {
+				t.Errorf("IsTraditionalChineseBytes() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkIsChineseBytes(b *testing.B) {
+	buf := []byte(strings.Repeat("在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户。", 100))
+	b.Run("string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsChinese(string(buf))
+		}
+	})
+	b.Run("bytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			IsChineseBytes(buf)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package ischinese
+
+import "unicode/utf8"
+
+// Script classification of Chinese text
+type Script int
+
+const (
+	// ScriptUnknown not classified
+	ScriptUnknown Script = iota
+	// ScriptSimplified simplified Chinese
+	ScriptSimplified
+	// ScriptTraditional traditional Chinese
+	ScriptTraditional
+	// ScriptMixed both simplified and traditional Chinese
+	ScriptMixed
+	// ScriptNonChinese not Chinese, see IsChinese
+	ScriptNonChinese
+)
+
+// RuneClass classification of a unicode code point, see ForEachRune
+type RuneClass int
+
+const (
+	// RuneNonChinese not Chinese unicode
+	RuneNonChinese RuneClass = iota
+	// RuneSimplified Chinese unicode valid in simplified Chinese only
+	RuneSimplified
+	// RuneTraditional Chinese unicode valid in traditional Chinese only
+	RuneTraditional
+	// RuneShared Chinese unicode valid in both scripts, e.g. 人
+	RuneShared
+	// RunePunctuation Chinese punctuation, see IsCJKPunctuation
+	RunePunctuation
+)
+
+// classifyRune classify unicode code point as RunePunctuation, RuneSimplified or RuneTraditional if only valid in one script,
+// RuneShared for other Chinese unicode, or RuneNonChinese, for Default
+func classifyRune(r rune) RuneClass {
+	return Default().classifyRune(r)
+}
+
+// ForEachRune call fn with each unicode code point of s, its byte offset and its classification, see classifyRune
+func ForEachRune(s string, fn func(r rune, offset int, class RuneClass)) {
+	for i, r := range s {
+		fn(r, i, classifyRune(r))
+	}
+}
+
+// ScriptsPresent return the distinct classifications of the unicode code points of s in ascending order, see ForEachRune,
+// e.g. [RuneNonChinese, RuneSimplified] for "汉字abc". Empty s returns nil.
+func ScriptsPresent(s string) []RuneClass {
+	var present [RunePunctuation + 1]bool
+	for _, r := range s {
+		present[classifyRune(r)] = true
+	}
+	var res []RuneClass
+	for class, ok := range present {
+		if ok {
+			res = append(res, RuneClass(class))
+		}
+	}
+	return res
+}
+
+// SameScript true if a and b Classify to the same Script. Strings without simplified only or traditional only characters,
+// including empty strings, are ScriptUnknown, so "人口" matches "" but neither "人口国" (ScriptSimplified) nor "人口國".
+func SameScript(a, b string) bool {
+	return Classify(a) == Classify(b)
+}
+
+// mixedThreshold minimum ratio of Chinese unicode code points which are simplified only and traditional only for ScriptMixed
+const mixedThreshold = 0.1
+
+// Classify classify s by counting simplified only and traditional only unicode code points.
+// Characters valid in both scripts, such as 人 or 中, and non-ideograph Chinese unicode such as punctuation, count toward neither.
+// It returns ScriptUnknown for empty s or when s has no simplified only or traditional only unicode code points,
+// ScriptNonChinese when IsChinese is false, ScriptMixed when both counts are more than 10% of the Chinese unicode code points
+// or are equal, otherwise the script with the larger count.
+func Classify(s string) Script {
+	return Default().Classify(s)
+}
+
+// classifyReport the Script of a Chinese string by its Report, see Classify
+func classifyReport(report Report) Script {
+	chinese, simplified, traditional := report.Chinese, report.SimplifiedOnly, report.TraditionalOnly
+	switch {
+	case simplified == 0 && traditional == 0:
+		return ScriptUnknown
+	case simplified == traditional,
+		float64(simplified) > mixedThreshold*float64(chinese) && float64(traditional) > mixedThreshold*float64(chinese):
+		return ScriptMixed
+	case simplified > traditional:
+		return ScriptSimplified
+	default:
+		return ScriptTraditional
+	}
+}
+
+// ConversionPreservesLength true if converting s to target, ScriptSimplified or ScriptTraditional, keeps the number of unicode code points.
+// Unihan variants map a code point to a single code point, so with the embedded data it holds for every s,
+// it only fails for one-to-many mappings, which Unihan_Variants.txt does not have. Other targets do not convert and return true.
+func ConversionPreservesLength(s string, target Script) bool {
+	var converted string
+	switch target {
+	case ScriptSimplified:
+		converted = Convert2Simplified(s)
+	case ScriptTraditional:
+		converted = ToTraditional(s)
+	default:
+		return true
+	}
+	return utf8.RuneCountInString(converted) == utf8.RuneCountInString(s)
+}
+
+// IsMixed true if s has at least one simplified only and one traditional only unicode code point, e.g. 这个國家
+func IsMixed(s string) bool {
+	simplified, traditional, _, _ := MixedStats(s)
+	return simplified > 0 && traditional > 0
+}
+
+// MixedStats count simplified only, traditional only, shared and non Chinese unicode code points.
+// Shared are Chinese unicode valid in both scripts, e.g. 人 and Chinese punctuation.
+func MixedStats(s string) (simplified, traditional, shared, nonChinese int) {
+	report := Analyze(s)
+	shared = report.Chinese - report.SimplifiedOnly - report.TraditionalOnly
+	return report.SimplifiedOnly, report.TraditionalOnly, shared, report.Total - report.Chinese
+}
+
+// Detect return the most likely script of s, ScriptSimplified, ScriptTraditional or ScriptNonChinese, and a confidence in [0, 1].
+// For Chinese text, see IsChinese, the confidence is |simplified only - traditional only| / Chinese unicode code points,
+// so characters valid in both scripts lower it, and only shared characters or a tie return ScriptUnknown with confidence 0.
+// For non Chinese text it is the ratio of non Chinese unicode code points. Empty string returns ScriptUnknown with confidence 0.
+func Detect(s string) (Script, float64) {
+	report := Analyze(s)
+	if report.Total == 0 {
+		return ScriptUnknown, 0
+	}
+	if !IsChinese(s) {
+		return ScriptNonChinese, float64(report.Total-report.Chinese) / float64(report.Total)
+	}
+	margin := report.SimplifiedOnly - report.TraditionalOnly
+	switch {
+	case margin > 0:
+		return ScriptSimplified, float64(margin) / float64(report.Chinese)
+	case margin < 0:
+		return ScriptTraditional, float64(-margin) / float64(report.Chinese)
+	default:
+		return ScriptUnknown, 0
+	}
+}
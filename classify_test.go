@@ -0,0 +1,337 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConversionPreservesLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		target Script
+		want   bool
+	}{
+		{
+			s:      "",
+			target: ScriptSimplified,
+			want:   true,
+		},
+		{
+			s:      "大劉說說",
+			target: ScriptSimplified,
+			want:   true,
+		},
+		{
+			s:      "大刘说说 hello",
+			target: ScriptTraditional,
+			want:   true,
+		},
+		{
+			s:      "invalid \xe4\xbd utf-8",
+			target: ScriptTraditional,
+			want:   true,
+		},
+		{
+			s:      "大刘说说",
+			target: ScriptUnknown,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConversionPreservesLength(tt.s, tt.target); got != tt.want {
+				t.Errorf("ConversionPreservesLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want Script
+	}{
+		{
+			s:    "",
+			want: ScriptUnknown,
+		},
+		{
+			s:    "hello world",
+			want: ScriptNonChinese,
+		},
+		{
+			s:    "こんにちは世界",
+			want: ScriptNonChinese,
+		},
+		{
+			s:    "人中你",
+			want: ScriptUnknown,
+		},
+		{
+			s:    "你很機車哎",
+			want: ScriptTraditional,
+		},
+		{
+			s:    "【厉害的陈友谅】",
+			want: ScriptSimplified,
+		},
+		{
+			s:    "这个國家",
+			want: ScriptMixed,
+		},
+		{
+			s:    "国國",
+			want: ScriptMixed,
+		},
+		// source: 「明朝那些事儿」
+		{
+			s:    "在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户，但他却很尊敬朱元璋，在军营里，人们可以看到一个奇特的现象，官职高得多的汤和总是走在士兵朱元璋的后边，并且毫不在意他人的眼神，更奇特的是朱元璋似乎认为这是理所应当的事情，也没有推托过。",
+			want: ScriptSimplified,
+		},
+		// source: https://zh.wikipedia.org/wiki/%E5%B0%84%E9%B5%B0%E8%8B%B1%E9%9B%84%E5%82%B3
+		{
+			s:    "然而連載《射鵰英雄傳》期間，因為金庸在長城電影公司擔任編劇和導演，瑣事繁多，精力時有不殆，所以小說中有很多情節他本人並不是非常滿意。",
+			want: ScriptTraditional,
+		},
+		{
+			s:    "在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户。然而連載《射鵰英雄傳》期間，因為金庸在長城電影公司擔任編劇。",
+			want: ScriptMixed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.s); got != tt.want {
+				t.Errorf("Classify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMixedStats(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		wantSimplified  int
+		wantTraditional int
+		wantShared      int
+		wantNonChinese  int
+		wantMixed       bool
+	}{
+		{
+			s: "",
+		},
+		{
+			s:              "hello",
+			wantNonChinese: 5,
+		},
+		{
+			s:              "这个国家",
+			wantSimplified: 3,
+			wantShared:     1,
+		},
+		{
+			s:               "這個國家",
+			wantTraditional: 3,
+			wantShared:      1,
+		},
+		{
+			s:               "这个國家，OK",
+			wantSimplified:  2,
+			wantTraditional: 1,
+			wantShared:      2,
+			wantNonChinese:  2,
+			wantMixed:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simplified, traditional, shared, nonChinese := MixedStats(tt.s)
+			if simplified != tt.wantSimplified || traditional != tt.wantTraditional || shared != tt.wantShared || nonChinese != tt.wantNonChinese {
+				t.Errorf("MixedStats() = %v, %v, %v, %v, want %v, %v, %v, %v", simplified, traditional, shared, nonChinese,
+					tt.wantSimplified, tt.wantTraditional, tt.wantShared, tt.wantNonChinese)
+			}
+			if got := IsMixed(tt.s); got != tt.wantMixed {
+				t.Errorf("IsMixed() = %v, want %v", got, tt.wantMixed)
+			}
+		})
+	}
+}
+
+func TestForEachRune(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	type visit struct {
+		r      rune
+		offset int
+		class  RuneClass
+	}
+	var got []visit
+	ForEachRune("这個人，ok㎡", func(r rune, offset int, class RuneClass) {
+		got = append(got, visit{r, offset, class})
+	})
+	want := []visit{
+		{'这', 0, RuneSimplified},
+		{'個', 3, RuneTraditional},
+		{'人', 6, RuneShared},
+		{'，', 9, RunePunctuation},
+		{'o', 12, RuneNonChinese},
+		{'k', 13, RuneNonChinese},
+		{'㎡', 14, RuneShared},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEachRune() visited %v, want %v", got, want)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name           string
+		s              string
+		want           Script
+		wantConfidence float64
+	}{
+		{
+			s:              "",
+			want:           ScriptUnknown,
+			wantConfidence: 0,
+		},
+		{
+			s:              "hello",
+			want:           ScriptNonChinese,
+			wantConfidence: 1,
+		},
+		{
+			s:              "中文 hello",
+			want:           ScriptNonChinese,
+			wantConfidence: 0.75,
+		},
+		{
+			s:              "这个国家",
+			want:           ScriptSimplified,
+			wantConfidence: 0.75,
+		},
+		{
+			s:              "這個國家",
+			want:           ScriptTraditional,
+			wantConfidence: 0.75,
+		},
+		{
+			s:              "这个國家",
+			want:           ScriptSimplified,
+			wantConfidence: 0.25,
+		},
+		{
+			s:              "人中你",
+			want:           ScriptUnknown,
+			wantConfidence: 0,
+		},
+		{
+			s:              "国國",
+			want:           ScriptUnknown,
+			wantConfidence: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, confidence := Detect(tt.s)
+			if got != tt.want || confidence != tt.wantConfidence {
+				t.Errorf("Detect() = %v, %v, want %v, %v", got, confidence, tt.want, tt.wantConfidence)
+			}
+		})
+	}
+}
+
+func TestScriptsPresent(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want []RuneClass
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    "这個ok",
+			want: []RuneClass{RuneNonChinese, RuneSimplified, RuneTraditional},
+		},
+		{
+			s:    "这这这abc",
+			want: []RuneClass{RuneNonChinese, RuneSimplified},
+		},
+		{
+			s:    "人，個",
+			want: []RuneClass{RuneTraditional, RuneShared, RunePunctuation},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScriptsPresent(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScriptsPresent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameScript(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{
+			a:    "",
+			b:    "",
+			want: true,
+		},
+		{
+			a:    "喜欢锻炼的人",
+			b:    "这个国家",
+			want: true,
+		},
+		{
+			a:    "喜欢锻炼的人",
+			b:    "喜歡鍛煉的人",
+			want: false,
+		},
+		{
+			a:    "射鵰英雄傳",
+			b:    "這個國家",
+			want: true,
+		},
+		{
+			a:    "喜欢锻炼的人",
+			b:    "hello world",
+			want: false,
+		},
+		{
+			a:    "hello world",
+			b:    "bonjour",
+			want: true,
+		},
+		{
+			a:    "人口",
+			b:    "",
+			want: true,
+		},
+		{
+			a:    "人口",
+			b:    "人口国",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameScript(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package ischinese
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// StripFormatControls remove format characters (unicode category Cf), e.g. soft hyphen U+00AD, zero width space U+200B
+// and bidi controls U+202A–U+202E. They are invisible, so visible content is preserved.
+func StripFormatControls(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// invisibleRange sorted and disjoint ranges of invisible characters CleanInvisible removes
+var invisibleRange = [][]rune{
+	{'\u00AD', '\u00AD'},         // soft hyphen
+	{'\u034F', '\u034F'},         // combining grapheme joiner
+	{'\u180E', '\u180E'},         // Mongolian vowel separator
+	{'\u200B', '\u200D'},         // zero width space, non-joiner and joiner
+	{'\u2060', '\u2064'},         // word joiner and invisible math operators
+	{'\uFE00', '\uFE0F'},         // variation selectors
+	{'\uFEFF', '\uFEFF'},         // byte order mark, zero width no-break space
+	{'\U000E0100', '\U000E01EF'}, // variation selectors supplement, e.g. ideographic variation sequences
+}
+
+// CleanInvisible remove invisible characters copied along with web text: U+00AD, U+034F, U+180E, U+200B–U+200D, U+2060–U+2064,
+// variation selectors U+FE00–U+FE0F and U+E0100–U+E01EF, and the byte order mark U+FEFF.
+// Unlike StripFormatControls it keeps bidi controls and other format characters, and it removes variation selectors,
+// which are nonspacing marks selecting a glyph of the previous character.
+func CleanInvisible(s string) string {
+	return keepHelper(s, func(r rune) bool {
+		return !inRanges(r, invisibleRange)
+	})
+}
+
+// KeepChinese remove unicode code points which are not Chinese unicode, preserving order.
+// Chinese punctuation, e.g. ，and 。, is Chinese unicode and retained.
+func KeepChinese(s string) string {
+	return keepHelper(s, isChineseChar)
+}
+
+// KeepSimplifiedChinese remove unicode code points which are not simplified Chinese unicode, preserving order
+func KeepSimplifiedChinese(s string) string {
+	return keepHelper(s, isSimplifiedChineseChar)
+}
+
+// KeepTraditionalChinese remove unicode code points which are not traditional Chinese unicode, preserving order
+func KeepTraditionalChinese(s string) string {
+	return keepHelper(s, isTraditionalChineseChar)
+}
+
+func keepHelper(s string, f func(rune) bool) string {
+	return strings.Map(func(r rune) rune {
+		if !f(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+var (
+	markdownLinkRegexp   = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	bbcodeTagRegexp      = regexp.MustCompile(`\[/?[a-zA-Z*]+(=[^\]]*)?\]`)
+	markdownPrefixRegexp = regexp.MustCompile(`(?m)^[ \t]*(#{1,6}|>+|[-*+])[ \t]+`)
+	codeBlockRegexp      = regexp.MustCompile("(?s)```.*?```")
+	codeSpanRegexp       = regexp.MustCompile("`[^`\n]+`")
+	// emphasis markers wrapping text, strongest first, not inside ASCII words or numbers, e.g. snake_case or 2*3*4
+	markdownEmphRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`(^|[^A-Za-z0-9_*])\*\*(\S|\S.*?\S)\*\*($|[^A-Za-z0-9_*])`),
+		regexp.MustCompile(`(^|[^A-Za-z0-9_*])__(\S|\S.*?\S)__($|[^A-Za-z0-9_*])`),
+		regexp.MustCompile(`(^|[^A-Za-z0-9_*])\*(\S|\S.*?\S)\*($|[^A-Za-z0-9_*])`),
+		regexp.MustCompile(`(^|[^A-Za-z0-9_*])_(\S|\S.*?\S)_($|[^A-Za-z0-9_*])`),
+	}
+)
+
+// StripMarkup remove common lightweight markup, so that detection focuses on prose.
+// It is a conservative best-effort stripper, not a parser:
+// markdown links and images keep their text, BBCode tags like [b], [/b] and [url=...] are removed,
+// heading (#), blockquote (>) and list markers are removed at line start, and emphasis markers *, **, _ and __ are removed
+// where they wrap text within a line, but not inside ASCII words or numbers, so snake_case and 2*3*4 are left untouched.
+func StripMarkup(s string) string {
+	s = markdownLinkRegexp.ReplaceAllString(s, "$1")
+	s = bbcodeTagRegexp.ReplaceAllString(s, "")
+	s = markdownPrefixRegexp.ReplaceAllString(s, "")
+	// matches consume the character after the closing marker, repeat for adjacent emphasis, e.g. "*a* *b*"
+	for {
+		stripped := s
+		for _, re := range markdownEmphRegexps {
+			stripped = re.ReplaceAllString(stripped, "${1}${2}${3}")
+		}
+		if stripped == s {
+			return s
+		}
+		s = stripped
+	}
+}
+
+// ExcludeCodeSpans remove code delimited by backticks, so "用 `go build` 编译" becomes "用  编译".
+// Fenced blocks between triple backticks are removed first and may span lines,
+// then inline spans between single backticks on the same line. Unmatched backticks are kept.
+func ExcludeCodeSpans(s string) string {
+	s = codeBlockRegexp.ReplaceAllString(s, "")
+	return codeSpanRegexp.ReplaceAllString(s, "")
+}
+
+// isBidiControl true for bidi embedding and override controls U+202A–U+202E and isolates U+2066–U+2069, all format characters
+func isBidiControl(r rune) bool {
+	return ('\u202A' <= r && r <= '\u202E') || ('\u2066' <= r && r <= '\u2069')
+}
+
+// HasMisplacedBidiControls true if a bidi control (U+202A–U+202E, U+2066–U+2069) is next to a CJK ideograph,
+// ignoring other bidi controls in between. Chinese is left-to-right, so this usually means a copy error, see StripFormatControls.
+func HasMisplacedBidiControls(s string) bool {
+	prevHan, pending := false, false
+	for _, r := range s {
+		if isBidiControl(r) {
+			if prevHan {
+				return true
+			}
+			pending = true
+			continue
+		}
+		prevHan = isIdeographChar(r)
+		if pending && prevHan {
+			return true
+		}
+		pending = false
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestStripFormatControls(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "你好 world",
+			want: "你好 world",
+		},
+		{
+			s:    "‪你好‬，­世界​",
+			want: "你好，世界",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripFormatControls(tt.s); got != tt.want {
+				t.Errorf("StripFormatControls() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCleanInvisible(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "你好 world",
+			want: "你好 world",
+		},
+		{
+			s:    "\uFEFF你\u200B好\u200C，\u200D世\u2060界\u00AD",
+			want: "你好，世界",
+		},
+		// ideographic variation sequence
+		{
+			s:    "葛\U000E0100城",
+			want: "葛城",
+		},
+		// bidi controls are kept
+		{
+			s:    "\u202A你好\u202C",
+			want: "\u202A你好\u202C",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanInvisible(tt.s); got != tt.want {
+				t.Errorf("CleanInvisible() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripMarkup(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "你好，世界",
+			want: "你好，世界",
+		},
+		{
+			s:    "## 标题\n> **重要**的_事情_",
+			want: "标题\n重要的事情",
+		},
+		{
+			s:    "[b]粗体[/b][color=red]红色[/color]",
+			want: "粗体红色",
+		},
+		{
+			s:    "看[这里](https://example.com)和![图片](a.png)",
+			want: "看这里和图片",
+		},
+		{
+			s:    "- 第一\n* 第二",
+			want: "第一\n第二",
+		},
+		{
+			name: "adjacent emphasis",
+			s:    "*强调* **加粗** __下划线__",
+			want: "强调 加粗 下划线",
+		},
+		{
+			name: "snake_case",
+			s:    "变量 snake_case_name 和 __init__ 方法",
+			want: "变量 snake_case_name 和 init 方法",
+		},
+		{
+			name: "arithmetic",
+			s:    "计算 2*3*4 和 2 * 3 * 4",
+			want: "计算 2*3*4 和 2 * 3 * 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripMarkup(tt.s); got != tt.want {
+				t.Errorf("StripMarkup() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcludeCodeSpans(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "用 `go build` 编译",
+			want: "用  编译",
+		},
+		{
+			s:    "示例：\n```go\nfmt.Println(`hi`)\n```\n结束",
+			want: "示例：\n\n结束",
+		},
+		{
+			s:    "单个`反引号",
+			want: "单个`反引号",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExcludeCodeSpans(tt.s); got != tt.want {
+				t.Errorf("ExcludeCodeSpans() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasMisplacedBidiControls(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "你好世界",
+			want: false,
+		},
+		{
+			s:    "\u2067你好\u2069",
+			want: true,
+		},
+		{
+			s:    "abc\u202E\u202Cdef 你好",
+			want: false,
+		},
+		{
+			s:    "你好 \u202Bשלום\u202C",
+			want: false,
+		},
+		{
+			s:    "你好\u202E",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasMisplacedBidiControls(tt.s); got != tt.want {
+				t.Errorf("HasMisplacedBidiControls() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepChinese(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		want            string
+		wantSimplified  string
+		wantTraditional string
+	}{
+		{
+			s:               "",
+			want:            "",
+			wantSimplified:  "",
+			wantTraditional: "",
+		},
+		{
+			s:               "hello 😀",
+			want:            "",
+			wantSimplified:  "",
+			wantTraditional: "",
+		},
+		{
+			s:               "Go语言😀很好，hello world！",
+			want:            "语言很好，！",
+			wantSimplified:  "语言很好，！",
+			wantTraditional: "言很好，！",
+		},
+		{
+			s:               "国國 a 人",
+			want:            "国國人",
+			wantSimplified:  "国人",
+			wantTraditional: "國人",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KeepChinese(tt.s); got != tt.want {
+				t.Errorf("KeepChinese() = %v, want %v", got, tt.want)
+			}
+			if got := KeepSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("KeepSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := KeepTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("KeepTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
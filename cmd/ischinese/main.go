@@ -0,0 +1,94 @@
+// Command ischinese print whether each line of stdin, or of the files given as arguments, is Chinese.
+//
+// Usage:
+//
+//	ischinese [-mode any|simplified|traditional] [-threshold 0.5] [-pure] [-require] [file ...]
+//
+// It prints true or false for each line. With -require it exits with status 1 if any line is false,
+// usage and read errors exit with status 2.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xujiahua/ischinese"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// detectors by mode, the threshold and the pure flavors
+var detectors = map[string]struct {
+	threshold func(string, float64) bool
+	pure      func(string) bool
+}{
+	"any":         {ischinese.IsChineseWithThreshold, ischinese.IsPureChinese},
+	"simplified":  {ischinese.IsSimplifiedChineseWithThreshold, ischinese.IsPureSimplifiedChinese},
+	"traditional": {ischinese.IsTraditionalChineseWithThreshold, ischinese.IsPureTraditionalChinese},
+}
+
+// run the command and return the exit status
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("ischinese", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	mode := flags.String("mode", "any", "any, simplified or traditional Chinese")
+	threshold := flags.Float64("threshold", 0.5, "a line is Chinese if more than this ratio of its unicode code points are")
+	pure := flags.Bool("pure", false, "a line is Chinese if all of its unicode code points are, overrides -threshold")
+	require := flags.Bool("require", false, "exit with status 1 if any line is not Chinese")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	detector, ok := detectors[*mode]
+	if !ok {
+		fmt.Fprintf(stderr, "invalid mode %q, want any, simplified or traditional\n", *mode)
+		return 2
+	}
+	isChinese := func(s string) bool {
+		if *pure {
+			return detector.pure(s)
+		}
+		return detector.threshold(s, *threshold)
+	}
+
+	w := bufio.NewWriter(stdout)
+	defer w.Flush()
+	failed := false
+	check := func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			verdict := isChinese(scanner.Text())
+			failed = failed || !verdict
+			fmt.Fprintln(w, verdict)
+		}
+		return scanner.Err()
+	}
+
+	if flags.NArg() == 0 {
+		if err := check(stdin); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+	for _, name := range flags.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		err = check(f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+	if *require && failed {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xujiahua/ischinese"
+)
+
+func TestRun(t *testing.T) {
+	// the package dictionaries are empty with the build tag ischinese_noembed
+	if ischinese.TraditionalVariants('国') == nil {
+		t.Skip("simplified and traditional modes need the embedded Unihan_Variants.txt")
+	}
+	input := "中文\nhello\n国家\n國家\n中文 abc\n"
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "default",
+			args:       nil,
+			want:       "true\nfalse\ntrue\ntrue\nfalse\n",
+			wantStatus: 0,
+		},
+		{
+			name:       "threshold",
+			args:       []string{"-threshold", "0.2"},
+			want:       "true\nfalse\ntrue\ntrue\ntrue\n",
+			wantStatus: 0,
+		},
+		{
+			name:       "simplified",
+			args:       []string{"-mode", "simplified"},
+			want:       "true\nfalse\ntrue\nfalse\nfalse\n",
+			wantStatus: 0,
+		},
+		{
+			name:       "traditional pure require",
+			args:       []string{"-mode", "traditional", "-pure", "-require"},
+			want:       "true\nfalse\nfalse\ntrue\nfalse\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "invalid mode",
+			args:       []string{"-mode", "korean"},
+			want:       "",
+			wantStatus: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if got := run(tt.args, strings.NewReader(input), &stdout, &stderr); got != tt.wantStatus {
+				t.Errorf("run() = %v, want %v, stderr %q", got, tt.wantStatus, stderr.String())
+			}
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run() output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunFiles(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(name, []byte("中文\nhello\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if got := run([]string{"-require", name, name}, strings.NewReader("ignored"), &stdout, &stderr); got != 1 {
+		t.Errorf("run() = %v, want %v", got, 1)
+	}
+	if got, want := stdout.String(), "true\nfalse\ntrue\nfalse\n"; got != want {
+		t.Errorf("run() output = %q, want %q", got, want)
+	}
+	if got := run([]string{filepath.Join(dir, "missing.txt")}, nil, &stdout, &stderr); got != 2 {
+		t.Errorf("run() = %v, want %v", got, 2)
+	}
+}
@@ -0,0 +1,25 @@
+package ischinese
+
+import "context"
+
+// contextCheckInterval number of unicode code points between checks of the context
+const contextCheckInterval = 1 << 12
+
+// IsChineseContext IsChinese for huge strings, returning ctx.Err() early once ctx is done.
+// The context is checked before the first and every 4096 unicode code points.
+func IsChineseContext(ctx context.Context, s string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	c := runeCounter{f: isChineseChar}
+	for _, r := range s {
+		// the first check is before the loop
+		if c.total > 0 && c.total%contextCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+		}
+		c.add(r)
+	}
+	return c.mostly(0.5), nil
+}
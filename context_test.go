@@ -0,0 +1,69 @@
+package ischinese
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// cancelAfterContext a context canceled once Err has been called n times
+type cancelAfterContext struct {
+	context.Context
+	n     int
+	calls int
+}
+
+func (c *cancelAfterContext) Err() error {
+	c.calls++
+	if c.calls > c.n {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestIsChineseContext(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello world",
+		},
+		{
+			s: "机车abc",
+		},
+		{
+			s: strings.Repeat("喜欢锻炼的人，身体应该比较好 ok ", 1000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsChineseContext(context.Background(), tt.s)
+			if err != nil || got != IsChinese(tt.s) {
+				t.Errorf("IsChineseContext() = %v, %v, want %v", got, err, IsChinese(tt.s))
+			}
+		})
+	}
+
+	// canceled mid-iteration
+	s := strings.Repeat("中文", 10*contextCheckInterval)
+	ctx := &cancelAfterContext{Context: context.Background(), n: 3}
+	if got, err := IsChineseContext(ctx, s); got || !errors.Is(err, context.Canceled) {
+		t.Errorf("IsChineseContext() = %v, %v, want %v, %v", got, err, false, context.Canceled)
+	}
+	if ctx.calls != 4 {
+		t.Errorf("IsChineseContext() checked the context %v times, want %v", ctx.calls, 4)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, s := range []string{"", "中文"} {
+		if got, err := IsChineseContext(canceled, s); got || !errors.Is(err, context.Canceled) {
+			t.Errorf("IsChineseContext(%q) = %v, %v, want %v, %v", s, got, err, false, context.Canceled)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package ischinese
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var chineseDigits = map[rune]int{
+	'〇': 0, '零': 0, '一': 1, '二': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'０': 0, '１': 1, '２': 2, '３': 3, '４': 4, '５': 5, '６': 6, '７': 7, '８': 8, '９': 9,
+}
+
+// lunarMonths month names used by the lunar calendar instead of numbers
+var lunarMonths = map[string]int{
+	"正": 1,
+	"冬": 11,
+	"腊": 12,
+	"臘": 12,
+}
+
+var errInvalidChineseDate = errors.New("invalid chinese date")
+
+// parseDigits parse digit by digit, e.g. 二〇二一 or 2021
+func parseDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	n := 0
+	for _, r := range s {
+		d, ok := chineseDigits[r]
+		if !ok {
+			return 0, false
+		}
+		n = n*10 + d
+	}
+	return n, true
+}
+
+// tensChars 十 is ten, or a multiplier when preceded by a digit, 廿 and 卅 are twenty and thirty
+var tensChars = map[rune]int{'十': 1, '廿': 2, '卅': 3}
+
+// parseSmallNumber parse a number below 100 written with tens, e.g. 十二, 二十五, 廿五, 卅一, or digit by digit, e.g. 12
+func parseSmallNumber(s string) (int, bool) {
+	runes := []rune(s)
+	for i, r := range runes {
+		tens, ok := tensChars[r]
+		if !ok {
+			continue
+		}
+		if i > 1 || (i == 1 && tens != 1) {
+			return 0, false
+		}
+		if i == 1 {
+			if tens, ok = chineseDigits[runes[0]]; !ok {
+				return 0, false
+			}
+		}
+		units := 0
+		switch len(runes) - i - 1 {
+		case 0:
+		case 1:
+			if units, ok = chineseDigits[runes[i+1]]; !ok {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+		return tens*10 + units, true
+	}
+	return parseDigits(s)
+}
+
+// ParseChineseDate parse a date like "二〇二一年三月五日", "2021年3月5日" or "二〇二一年十二月二十五号".
+// Lunar-style forms like "二〇二一年正月初五" are parsed best-effort: lunar month names (正, 冬, 腊/臘) and days (初一 to 初十, 廿, 卅)
+// are read as numbers, but no lunar to Gregorian conversion is done. The result is in UTC.
+func ParseChineseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	yearEnd := strings.Index(s, "年")
+	if yearEnd < 0 {
+		return time.Time{}, errInvalidChineseDate
+	}
+	monthEnd := strings.Index(s, "月")
+	if monthEnd < yearEnd {
+		return time.Time{}, errInvalidChineseDate
+	}
+	year, ok := parseDigits(s[:yearEnd])
+	if !ok {
+		return time.Time{}, errInvalidChineseDate
+	}
+	monthStr := s[yearEnd+len("年") : monthEnd]
+	month, ok := lunarMonths[monthStr]
+	if !ok {
+		if month, ok = parseSmallNumber(monthStr); !ok {
+			return time.Time{}, errInvalidChineseDate
+		}
+	}
+	dayStr := s[monthEnd+len("月"):]
+	for _, suffix := range []string{"日", "号", "號"} {
+		dayStr = strings.TrimSuffix(dayStr, suffix)
+	}
+	dayStr = strings.TrimPrefix(dayStr, "初")
+	day, ok := parseSmallNumber(dayStr)
+	if !ok {
+		return time.Time{}, errInvalidChineseDate
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || t.Day() != day {
+		return time.Time{}, errInvalidChineseDate
+	}
+	return t, nil
+}
+
+// IsChineseDate true if s is a date ParseChineseDate can parse
+func IsChineseDate(s string) bool {
+	_, err := ParseChineseDate(s)
+	return err == nil
+}
@@ -0,0 +1,104 @@
+package ischinese
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseChineseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			s:    "二〇二一年三月五日",
+			want: time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:    "2021年3月5日",
+			want: time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:    "二零二一年十二月二十五号",
+			want: time.Date(2021, 12, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:    "一九九九年十月三十一日",
+			want: time.Date(1999, 10, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:    "二〇二一年正月初五",
+			want: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:    "二〇二一年腊月廿三",
+			want: time.Date(2021, 12, 23, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			s:       "",
+			wantErr: true,
+		},
+		{
+			s:       "二〇二一年二月三十日",
+			wantErr: true,
+		},
+		{
+			s:       "二〇二一年十三月一日",
+			wantErr: true,
+		},
+		{
+			s:       "今年三月五日",
+			wantErr: true,
+		},
+		{
+			s:       "三月五日",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChineseDate(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseChineseDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseChineseDate() got = %v, want %v", got, tt.want)
+			}
+			if got := IsChineseDate(tt.s); got != !tt.wantErr {
+				t.Errorf("IsChineseDate() = %v, want %v", got, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseSmallNumber(t *testing.T) {
+	tests := []struct {
+		s      string
+		want   int
+		wantOk bool
+	}{
+		{"五", 5, true},
+		{"十", 10, true},
+		{"十二", 12, true},
+		{"二十", 20, true},
+		{"二十五", 25, true},
+		{"廿三", 23, true},
+		{"卅", 30, true},
+		{"12", 12, true},
+		{"", 0, false},
+		{"十二三", 0, false},
+		{"二廿", 0, false},
+		{"一二十", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, ok := parseSmallNumber(tt.s)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("parseSmallNumber() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,792 @@
+package ischinese
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Detector detect Chinese with options, package level functions use a Detector with default options, see Default.
+// A Detector is safe for concurrent use, including AddVariant and LoadVariants while detecting.
+// The package dictionaries take no locks, LoadDictionaryFromFS swaps them atomically.
+type Detector struct {
+	ranges                [][]rune
+	inRanges              func(rune) bool
+	punctuationOnlyResult *bool
+	stripFormatControls   bool
+	cleanInvisible        bool
+	normalizeRadicals     bool
+	excludeCodeSpans      bool
+	fullwidthLatin        bool
+	radicals              bool
+	commonScript          bool
+	unicodeScript         bool
+	ignoreSpaceDigits     bool
+	relatedVariants       bool
+	// nil for no cache
+	cache *lruCache
+	// mu guard the variant dictionaries
+	mu sync.RWMutex
+	// nil for the package dictionaries
+	simplifiedDict  map[rune]rune
+	traditionalDict map[rune]rune
+	err             error
+}
+
+// Option configure a Detector
+type Option func(*Detector)
+
+// WithPunctuationOnlyResult set what IsChinese, IsPureChinese and their simplified and traditional variants return for strings of
+// Chinese punctuation only, e.g. "。，！". Default is true, as Chinese punctuation is in the Chinese unicode ranges. With false,
+// ChineseRatio and its variants return 0 for them.
+func WithPunctuationOnlyResult(result bool) Option {
+	return func(d *Detector) {
+		d.punctuationOnlyResult = &result
+	}
+}
+
+// WithStripFormatControls apply StripFormatControls to strings before detection
+func WithStripFormatControls(strip bool) Option {
+	return func(d *Detector) {
+		d.stripFormatControls = strip
+	}
+}
+
+// WithCleanInvisible apply CleanInvisible to strings before detection
+func WithCleanInvisible(clean bool) Option {
+	return func(d *Detector) {
+		d.cleanInvisible = clean
+	}
+}
+
+// WithStrictIdeographs only count CJK ideographs as Chinese, excluding
+// CJK Symbols and Punctuation (U+3000–U+303F), CJK Compatibility (U+3300–U+33FF, e.g. ㎡),
+// Vertical Forms (U+FE10–U+FE19), CJK Compatibility Forms (U+FE30–U+FE4F) and fullwidth punctuation.
+// It is opt-in for now and may become the default in v2, set WithStrictIdeographs(false) to keep the current behavior.
+func WithStrictIdeographs(strict bool) Option {
+	return func(d *Detector) {
+		if strict {
+			d.ranges = ideographTable
+		} else {
+			d.ranges = commonTable
+		}
+	}
+}
+
+var errInvalidRange = errors.New("invalid range")
+
+// WithRanges replace the ranges of Chinese unicode, e.g. [][]rune{{'\u4E00', '\u9FFC'}} for CJK Unified Ideographs only.
+// Each range is a pair of its first and last unicode code point, ranges may overlap and be in any order.
+// Invalid ranges are reported by Err and leave the ranges unchanged, it overrides and is overridden by WithStrictIdeographs.
+func WithRanges(ranges [][]rune) Option {
+	return func(d *Detector) {
+		for _, r := range ranges {
+			if len(r) != 2 || r[0] > r[1] {
+				if d.err == nil {
+					d.err = fmt.Errorf("%w: %U", errInvalidRange, r)
+				}
+				return
+			}
+		}
+		d.ranges = mergeRanges(ranges)
+	}
+}
+
+// WithNormalizeRadicals apply NormalizeRadicals to strings before detection,
+// so a radical is classified as its equivalent ideograph, which is looked up in the variant dictionaries as usual,
+// e.g. ⾨ (U+2FA8) is traditional like 門 and ⻳ (U+2EF3) is simplified like 龟.
+// Without it radicals are outside the Chinese unicode ranges.
+func WithNormalizeRadicals(normalize bool) Option {
+	return func(d *Detector) {
+		d.normalizeRadicals = normalize
+	}
+}
+
+// WithExcludeCodeSpans apply ExcludeCodeSpans to strings before detection,
+// so code in technical writing does not count against Chinese prose. Code only, e.g. "`rm -rf /`", is not Chinese.
+func WithExcludeCodeSpans(exclude bool) Option {
+	return func(d *Detector) {
+		d.excludeCodeSpans = exclude
+	}
+}
+
+// WithFullwidthLatin count fullwidth Latin letters (Ａ-Ｚ, ａ-ｚ) as Chinese, as they are used in CJK typesetting,
+// so "ＡＢ你好" is pure Chinese. Default is false, they are Latin.
+func WithFullwidthLatin(chinese bool) Option {
+	return func(d *Detector) {
+		d.fullwidthLatin = chinese
+	}
+}
+
+// WithRadicals count Kangxi Radicals (U+2F00–U+2FD5) and CJK Radicals Supplement (U+2E80–U+2EF3), e.g. ⼀ or ⺁, as Chinese,
+// as dictionaries and educational content use them as characters. Default is false, see also WithNormalizeRadicals.
+func WithRadicals(chinese bool) Option {
+	return func(d *Detector) {
+		d.radicals = chinese
+	}
+}
+
+// WithCommonScript include "common" in RequiredScripts when s contains whitespace, punctuation, digits or symbols
+func WithCommonScript(include bool) Option {
+	return func(d *Detector) {
+		d.commonScript = include
+	}
+}
+
+// WithUnicodeScriptProperty detect ideographs with the Han script property of the unicode package, following Go's unicode version,
+// instead of the hand maintained ideograph ranges. Han excludes CJK punctuation and symbols, which are still taken from the ranges.
+// Han includes code points the ranges miss, e.g. Kangxi radicals and ideographs newer than the ranges,
+// and misses some the ranges have, e.g. unassigned code points in the CJK Compatibility Ideographs block.
+func WithUnicodeScriptProperty(enabled bool) Option {
+	return func(d *Detector) {
+		d.unicodeScript = enabled
+	}
+}
+
+// IgnoreWhitespaceAndDigits drop whitespace and decimal digits before detection, so they count toward neither Chinese nor total,
+// e.g. "你好 2021" is pure Chinese. Whitespace is the unicode White_Space property (unicode.IsSpace), including the ideographic space U+3000,
+// digits are unicode category Nd (unicode.IsDigit), including fullwidth digits. Whitespace and digits only is not Chinese.
+func IgnoreWhitespaceAndDigits(ignore bool) Option {
+	return func(d *Detector) {
+		d.ignoreSpaceDigits = ignore
+	}
+}
+
+// WithCache memoize IsChinese results of the last size distinct strings, for workloads checking the same strings repeatedly,
+// e.g. tags or categories. Cached strings are kept in memory, a size of 0 or less disables the cache, which is the default.
+func WithCache(size int) Option {
+	return func(d *Detector) {
+		if size > 0 {
+			d.cache = newLRUCache(size)
+		} else {
+			d.cache = nil
+		}
+	}
+}
+
+// WithRelatedVariants classify a character in neither variant dictionary as its first related variant in one of them, see RelatedVariants,
+// e.g. 説 as traditional like its z-variant 說. Default is false, related variants never affect detection.
+func WithRelatedVariants(enabled bool) Option {
+	return func(d *Detector) {
+		d.relatedVariants = enabled
+	}
+}
+
+// WithVariantFile merge kSimplifiedVariant and kTraditionalVariant lines in Unihan_Variants.txt format read from r
+// into the variant dictionaries of the Detector, the package dictionaries are left untouched. Parse errors are reported by Err.
+func WithVariantFile(r io.Reader) Option {
+	return func(d *Detector) {
+		if d.err != nil {
+			return
+		}
+		d.err = d.LoadVariants(r)
+	}
+}
+
+// NewDetector create a Detector with options
+func NewDetector(opts ...Option) *Detector {
+	return newDetector(nil, nil, opts)
+}
+
+// NewDetectorFromReader create a Detector with options whose variant dictionaries are parsed from r in Unihan_Variants.txt format
+// instead of copied from the package dictionaries, which are never built for it. Along with the build tag ischinese_noembed,
+// which leaves the 610 KiB Unihan_Variants.txt out of the binary, e.g. for WebAssembly, the file can be fetched at runtime instead.
+// The package dictionaries are empty then, so package level simplified and traditional detection count every Chinese unicode as both.
+// Parse errors and a file without variants are returned, errors applying options are reported by Err.
+func NewDetectorFromReader(r io.Reader, opts ...Option) (*Detector, error) {
+	simplified := make(map[rune]rune)
+	traditional := make(map[rune]rune)
+	if err := parseVariants(r, simplified, traditional); err != nil {
+		return nil, err
+	}
+	if len(simplified) == 0 && len(traditional) == 0 {
+		return nil, errEmptyDictionary
+	}
+	return newDetector(simplified, traditional, opts), nil
+}
+
+// newDetector create a Detector with variant dictionaries, nil for the package dictionaries, and options
+func newDetector(simplifiedDict, traditionalDict map[rune]rune, opts []Option) *Detector {
+	d := &Detector{
+		ranges:          commonTable,
+		simplifiedDict:  simplifiedDict,
+		traditionalDict: traditionalDict,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.inRanges = rangesFunc(d.ranges)
+	return d
+}
+
+// rangesFunc return a membership test of sorted and merged ranges, the generated isChineseCharSwitch for commonTable
+func rangesFunc(ranges [][]rune) func(rune) bool {
+	if len(ranges) > 0 && len(ranges) == len(commonTable) && &ranges[0] == &commonTable[0] {
+		return isChineseCharSwitch
+	}
+	return func(r rune) bool {
+		return inRanges(r, ranges)
+	}
+}
+
+var defaultDetector = NewDetector()
+
+// Default return the Detector with default options all package level functions delegate to, using the package dictionaries,
+// so it follows LoadDictionaryFromFS. It is shared, AddVariant and LoadVariants return an error for it, build a Detector with NewDetector instead.
+func Default() *Detector {
+	return defaultDetector
+}
+
+var errDefaultDetector = errors.New("the default detector is shared and never changes, use NewDetector")
+
+// Err return the first error applying options, e.g. parsing WithVariantFile
+func (d *Detector) Err() error {
+	return d.err
+}
+
+// IsChineseRune true if unicode code point is Chinese unicode
+func (d *Detector) IsChineseRune(r rune) bool {
+	return d.isChineseChar(r)
+}
+
+// IsSimplifiedChineseRune true if unicode code point is simplified Chinese unicode
+func (d *Detector) IsSimplifiedChineseRune(r rune) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.isSimplifiedChineseChar(r)
+}
+
+// IsTraditionalChineseRune true if unicode code point is traditional Chinese unicode
+func (d *Detector) IsTraditionalChineseRune(r rune) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.isTraditionalChineseChar(r)
+}
+
+// IsChineseReader true if more than 50% of unicode code points read from r are Chinese unicode, see IsChineseReader
+func (d *Detector) IsChineseReader(r io.Reader) (bool, error) {
+	return nonPureReaderHelper(r, d.isChineseChar, 0.5)
+}
+
+// IsSimplifiedChineseReader true if more than 50% of unicode code points read from r are simplified Chinese unicode
+func (d *Detector) IsSimplifiedChineseReader(r io.Reader) (bool, error) {
+	return nonPureReaderHelper(r, d.locked(d.isSimplifiedChineseChar), 0.5)
+}
+
+// IsTraditionalChineseReader true if more than 50% of unicode code points read from r are traditional Chinese unicode
+func (d *Detector) IsTraditionalChineseReader(r io.Reader) (bool, error) {
+	return nonPureReaderHelper(r, d.locked(d.isTraditionalChineseChar), 0.5)
+}
+
+// IsChineseBytes same as IsChinese(string(b)), copying b only if the Detector changes strings before detection, see IsChineseBytes
+func (d *Detector) IsChineseBytes(b []byte) bool {
+	if d.needsString() {
+		return d.IsChinese(string(b))
+	}
+	return nonPureBytesHelper(b, d.isChineseChar, 0.5)
+}
+
+// IsSimplifiedChineseBytes same as IsSimplifiedChinese(string(b)), see IsChineseBytes
+func (d *Detector) IsSimplifiedChineseBytes(b []byte) bool {
+	if d.needsString() {
+		return d.IsSimplifiedChinese(string(b))
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return nonPureBytesHelper(b, d.isSimplifiedChineseChar, 0.5)
+}
+
+// IsTraditionalChineseBytes same as IsTraditionalChinese(string(b)), see IsChineseBytes
+func (d *Detector) IsTraditionalChineseBytes(b []byte) bool {
+	if d.needsString() {
+		return d.IsTraditionalChinese(string(b))
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return nonPureBytesHelper(b, d.isTraditionalChineseChar, 0.5)
+}
+
+// IsChinese true if more than 50% of unicode code points are Chinese unicode
+func (d *Detector) IsChinese(s string) bool {
+	if d.cache == nil {
+		return d.IsChineseWithThreshold(s, 0.5)
+	}
+	if result, ok := d.cache.get(s); ok {
+		return result
+	}
+	result := d.IsChineseWithThreshold(s, 0.5)
+	d.cache.add(s, result)
+	return result
+}
+
+// IsChineseWithThreshold true if more than ratio of unicode code points are Chinese unicode, exactly ratio is false
+func (d *Detector) IsChineseWithThreshold(s string, ratio float64) bool {
+	s, ok := d.prepareDetect(s)
+	if !ok || (ratio >= 0 && d.isASCII(s)) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsMostly(s, d.isChineseChar, ratio)
+}
+
+// ChineseRatio return the ratio of unicode code points which are Chinese unicode, 0 for empty string
+func (d *Detector) ChineseRatio(s string) float64 {
+	s = d.prepare(s)
+	if result, ok := d.punctuationOnly(s); ok && !result {
+		return 0
+	}
+	return ratioHelper(s, d.isChineseChar)
+}
+
+// SimplifiedChineseRatio return the ratio of unicode code points which are simplified Chinese unicode, 0 for empty string
+func (d *Detector) SimplifiedChineseRatio(s string) float64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s = d.prepare(s)
+	if result, ok := d.punctuationOnly(s); ok && !result {
+		return 0
+	}
+	return ratioHelper(s, d.isSimplifiedChineseChar)
+}
+
+// TraditionalChineseRatio return the ratio of unicode code points which are traditional Chinese unicode, 0 for empty string
+func (d *Detector) TraditionalChineseRatio(s string) float64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s = d.prepare(s)
+	if result, ok := d.punctuationOnly(s); ok && !result {
+		return 0
+	}
+	return ratioHelper(s, d.isTraditionalChineseChar)
+}
+
+// CountChinese return the number of unicode code points which are Chinese unicode
+func (d *Detector) CountChinese(s string) int {
+	return countHelper(d.prepare(s), d.isChineseChar)
+}
+
+// CountSimplifiedChinese return the number of unicode code points which are simplified Chinese unicode
+func (d *Detector) CountSimplifiedChinese(s string) int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return countHelper(d.prepare(s), d.isSimplifiedChineseChar)
+}
+
+// CountTraditionalChinese return the number of unicode code points which are traditional Chinese unicode
+func (d *Detector) CountTraditionalChinese(s string) int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return countHelper(d.prepare(s), d.isTraditionalChineseChar)
+}
+
+// IsPureChinese true if 100% of unicode code points are Chinese unicode
+func (d *Detector) IsPureChinese(s string) bool {
+	s, ok := d.prepareDetect(s)
+	if !ok || d.isASCII(s) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsAll(s, d.isChineseChar)
+}
+
+// IsNearlyPureChinese true if at most maxNonChinese unicode code points are not Chinese unicode, see IsNearlyPureChinese
+func (d *Detector) IsNearlyPureChinese(s string, maxNonChinese int) bool {
+	for _, r := range d.prepare(s) {
+		if !d.isChineseChar(r) {
+			debug(string([]rune{r}))
+			maxNonChinese--
+			if maxNonChinese < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsChineseIgnorePunctuation true if more than 50% of unicode code points other than Chinese punctuation are Chinese unicode,
+// see IsChineseIgnorePunctuation
+func (d *Detector) IsChineseIgnorePunctuation(s string) bool {
+	return IsMostly(keepHelper(d.prepare(s), func(r rune) bool {
+		return !isPunctuationChar(r)
+	}), d.isChineseChar, 0.5)
+}
+
+// IsChineseIdeographsOnly true if more than 50% of unicode code points other than skippable ones are CJK ideographs
+// which are Chinese unicode for the Detector, see IsChineseIdeographsOnly
+func (d *Detector) IsChineseIdeographsOnly(s string) bool {
+	return IsMostly(keepHelper(d.prepare(s), func(r rune) bool {
+		return !isNeutralChar(r) && !unicode.IsSymbol(r)
+	}), func(r rune) bool {
+		return isIdeographChar(r) && d.isChineseChar(r)
+	}, 0.5)
+}
+
+// ContainsChinese true if any unicode code point is Chinese unicode, false for empty string
+func (d *Detector) ContainsChinese(s string) bool {
+	return ContainsFunc(d.prepare(s), d.isChineseChar)
+}
+
+// ContainsSimplifiedChinese true if any unicode code point is simplified Chinese unicode, including characters valid in both scripts
+func (d *Detector) ContainsSimplifiedChinese(s string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return ContainsFunc(d.prepare(s), d.isSimplifiedChineseChar)
+}
+
+// ContainsTraditionalChinese true if any unicode code point is traditional Chinese unicode, including characters valid in both scripts
+func (d *Detector) ContainsTraditionalChinese(s string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return ContainsFunc(d.prepare(s), d.isTraditionalChineseChar)
+}
+
+// Analyze count the unicode code points of s by classification and collect the non Chinese ones with their byte offsets, see Analyze.
+// Offsets are in s as prepared by the Detector, e.g. without code spans with WithExcludeCodeSpans.
+func (d *Detector) Analyze(s string) Report {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var report Report
+	for i, r := range d.prepare(s) {
+		if !report.add(d.classifyRune(r)) {
+			report.NonChinese = append(report.NonChinese, RuneOffset{Rune: r, Offset: i})
+		}
+	}
+	return report
+}
+
+// Classify classify s by counting simplified only and traditional only unicode code points, see Classify
+func (d *Detector) Classify(s string) Script {
+	if len(s) == 0 {
+		return ScriptUnknown
+	}
+	if !d.IsChinese(s) {
+		return ScriptNonChinese
+	}
+	return classifyReport(d.Analyze(s))
+}
+
+// IsSimplifiedChinese true if more than 50% of unicode code points are simplified Chinese unicode
+func (d *Detector) IsSimplifiedChinese(s string) bool {
+	return d.IsSimplifiedChineseWithThreshold(s, 0.5)
+}
+
+// IsSimplifiedChineseWithThreshold true if more than ratio of unicode code points are simplified Chinese unicode, exactly ratio is false
+func (d *Detector) IsSimplifiedChineseWithThreshold(s string, ratio float64) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s, ok := d.prepareDetect(s)
+	if !ok || (ratio >= 0 && d.isASCII(s)) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsMostly(s, d.isSimplifiedChineseChar, ratio)
+}
+
+// IsTraditionalChinese true if more than 50% of unicode code points are traditional Chinese unicode
+func (d *Detector) IsTraditionalChinese(s string) bool {
+	return d.IsTraditionalChineseWithThreshold(s, 0.5)
+}
+
+// IsTraditionalChineseWithThreshold true if more than ratio of unicode code points are traditional Chinese unicode, exactly ratio is false
+func (d *Detector) IsTraditionalChineseWithThreshold(s string, ratio float64) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s, ok := d.prepareDetect(s)
+	if !ok || (ratio >= 0 && d.isASCII(s)) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsMostly(s, d.isTraditionalChineseChar, ratio)
+}
+
+// IsPureSimplifiedChinese true if 100% of unicode code points are simplified Chinese unicode
+func (d *Detector) IsPureSimplifiedChinese(s string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s, ok := d.prepareDetect(s)
+	if !ok || d.isASCII(s) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsAll(s, d.isSimplifiedChineseChar)
+}
+
+// IsPureTraditionalChinese true if 100% of unicode code points are traditional Chinese unicode
+func (d *Detector) IsPureTraditionalChinese(s string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	s, ok := d.prepareDetect(s)
+	if !ok || d.isASCII(s) {
+		return false
+	}
+	if result, ok := d.punctuationOnly(s); ok {
+		return result
+	}
+	return IsAll(s, d.isTraditionalChineseChar)
+}
+
+// RequiredScripts return the sorted distinct scripts of s, see RequiredScripts
+func (d *Detector) RequiredScripts(s string) []string {
+	return requiredScripts(d.prepare(s), d.commonScript)
+}
+
+func (d *Detector) isChineseChar(r rune) bool {
+	if d.fullwidthLatin && isFullwidthLatinChar(r) {
+		return true
+	}
+	if d.radicals && isRadicalChar(r) {
+		return true
+	}
+	if d.unicodeScript {
+		return unicode.Is(unicode.Han, r) || (d.inRanges(r) && !isIdeographChar(r))
+	}
+	return d.inRanges(r)
+}
+
+// isSimplifiedChineseChar the caller must hold the read lock
+func (d *Detector) isSimplifiedChineseChar(r rune) bool {
+	if !d.isChineseChar(r) {
+		return false
+	}
+	r = d.relatedVariant(r)
+	if d.simplifiedDict == nil {
+		return isSimplifiedVariant(r)
+	}
+	return isSimplifiedVariantIn(r, dictRuneSet(d.simplifiedDict), dictRuneSet(d.traditionalDict))
+}
+
+// isTraditionalChineseChar the caller must hold the read lock
+func (d *Detector) isTraditionalChineseChar(r rune) bool {
+	if !d.isChineseChar(r) {
+		return false
+	}
+	r = d.relatedVariant(r)
+	if d.simplifiedDict == nil {
+		return isTraditionalVariant(r)
+	}
+	return isTraditionalVariantIn(r, dictRuneSet(d.simplifiedDict), dictRuneSet(d.traditionalDict))
+}
+
+// relatedVariant r itself, unless WithRelatedVariants and r is in neither variant dictionary,
+// then its first related variant in one of them if any, the caller must hold the read lock
+func (d *Detector) relatedVariant(r rune) rune {
+	if !d.relatedVariants || isSharedChar(r) {
+		return r
+	}
+	var simplifiedDict, traditionalDict runeSet
+	if d.simplifiedDict == nil {
+		dicts := loadDictionaries()
+		simplifiedDict, traditionalDict = dicts.simplifiedDict, dicts.traditionalDict
+	} else {
+		simplifiedDict, traditionalDict = dictRuneSet(d.simplifiedDict), dictRuneSet(d.traditionalDict)
+	}
+	if simplifiedDict.contains(r) || traditionalDict.contains(r) {
+		return r
+	}
+	for _, v := range loadDictionaries().related()[r] {
+		if simplifiedDict.contains(v) || traditionalDict.contains(v) {
+			return v
+		}
+	}
+	return r
+}
+
+// ToSimplified replace traditional unicode code point with simplified one using the variant dictionaries of the Detector, see ToSimplified
+func (d *Detector) ToSimplified(s string) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var res []rune
+	for _, r := range s {
+		res = append(res, d.replace2SimplifiedChar(r))
+	}
+	return string(res)
+}
+
+// ToTraditional replace simplified unicode code point with traditional one using the variant dictionaries of the Detector, see ToTraditional
+func (d *Detector) ToTraditional(s string) string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var res []rune
+	for _, r := range s {
+		res = append(res, d.replace2TraditionalChar(r))
+	}
+	return string(res)
+}
+
+// replace2SimplifiedChar the caller must hold the read lock
+func (d *Detector) replace2SimplifiedChar(r rune) rune {
+	var replaced rune
+	var ok bool
+	if d.traditionalDict == nil {
+		replaced, ok = loadDictionaries().traditionalDict.lookup(r)
+	} else {
+		replaced, ok = d.traditionalDict[r]
+	}
+	if ok {
+		return replaced
+	}
+	return r
+}
+
+// replace2TraditionalChar the caller must hold the read lock
+func (d *Detector) replace2TraditionalChar(r rune) rune {
+	var replaced rune
+	var ok bool
+	if d.simplifiedDict == nil {
+		replaced, ok = loadDictionaries().simplifiedDict.lookup(r)
+	} else {
+		replaced, ok = d.simplifiedDict[r]
+	}
+	if ok {
+		return replaced
+	}
+	return r
+}
+
+// classifyRune see classifyRune, the caller must hold the read lock
+func (d *Detector) classifyRune(r rune) RuneClass {
+	switch {
+	case !d.isChineseChar(r):
+		return RuneNonChinese
+	case isPunctuationChar(r):
+		return RunePunctuation
+	}
+	isSimplified, isTraditional := d.isSimplifiedChineseChar(r), d.isTraditionalChineseChar(r)
+	switch {
+	case isSimplified && !isTraditional:
+		return RuneSimplified
+	case isTraditional && !isSimplified:
+		return RuneTraditional
+	default:
+		return RuneShared
+	}
+}
+
+// locked wrap f to hold the read lock for each call, for readers which must not hold it while blocked on I/O
+func (d *Detector) locked(f func(rune) bool) func(rune) bool {
+	return func(r rune) bool {
+		d.mu.RLock()
+		defer d.mu.RUnlock()
+		return f(r)
+	}
+}
+
+// AddVariant add a simplified and traditional variant pair to the variant dictionaries of the Detector,
+// the package dictionaries are left untouched. It returns an error for Default.
+func (d *Detector) AddVariant(simplified, traditional rune) error {
+	if d == defaultDetector {
+		return errDefaultDetector
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.copyOnWrite()
+	d.simplifiedDict[simplified] = traditional
+	d.traditionalDict[traditional] = simplified
+	return nil
+}
+
+// LoadVariants merge kSimplifiedVariant and kTraditionalVariant lines in Unihan_Variants.txt format read from r
+// into the variant dictionaries of the Detector, see WithVariantFile. Nothing is merged on error, and it returns an error for Default.
+func (d *Detector) LoadVariants(r io.Reader) error {
+	if d == defaultDetector {
+		return errDefaultDetector
+	}
+	simplified := make(map[rune]rune)
+	traditional := make(map[rune]rune)
+	if err := parseVariants(r, simplified, traditional); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.copyOnWrite()
+	for k, v := range simplified {
+		d.simplifiedDict[k] = v
+	}
+	for k, v := range traditional {
+		d.traditionalDict[k] = v
+	}
+	return nil
+}
+
+// copyOnWrite copy the package dictionaries before the first change, the caller must hold the write lock
+func (d *Detector) copyOnWrite() {
+	if d.simplifiedDict == nil {
+		dicts := loadDictionaries()
+		d.simplifiedDict = dicts.simplifiedDict.toMap()
+		d.traditionalDict = dicts.traditionalDict.toMap()
+	}
+}
+
+// isASCII true if s is non empty ASCII and no ASCII is Chinese unicode for d, so that detection can return early
+func (d *Detector) isASCII(s string) bool {
+	if len(s) == 0 || (len(d.ranges) > 0 && d.ranges[0][0] < utf8.RuneSelf) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// prepare clean s as configured before detection
+func (d *Detector) prepare(s string) string {
+	if d.excludeCodeSpans {
+		s = ExcludeCodeSpans(s)
+	}
+	if d.stripFormatControls {
+		s = StripFormatControls(s)
+	}
+	if d.cleanInvisible {
+		s = CleanInvisible(s)
+	}
+	if d.normalizeRadicals {
+		s = NormalizeRadicals(s)
+	}
+	if d.ignoreSpaceDigits {
+		s = keepHelper(s, func(r rune) bool {
+			return !unicode.IsSpace(r) && !unicode.IsDigit(r)
+		})
+	}
+	return s
+}
+
+// needsString true if the Detector changes strings before detection, so that []byte input is converted
+func (d *Detector) needsString() bool {
+	return d.excludeCodeSpans || d.stripFormatControls || d.cleanInvisible || d.normalizeRadicals || d.ignoreSpaceDigits ||
+		d.punctuationOnlyResult != nil
+}
+
+// prepareDetect prepare s for the Is* methods, false if preparation removed all of non empty s,
+// e.g. "2021" with IgnoreWhitespaceAndDigits or "`code`" with WithExcludeCodeSpans, which is not Chinese unlike empty string
+func (d *Detector) prepareDetect(s string) (string, bool) {
+	prepared := d.prepare(s)
+	return prepared, len(prepared) > 0 || len(s) == 0
+}
+
+// punctuationOnly return the configured result if s is Chinese punctuation only
+func (d *Detector) punctuationOnly(s string) (bool, bool) {
+	if d.punctuationOnlyResult == nil || len(s) == 0 {
+		return false, false
+	}
+	for _, r := range s {
+		if !isPunctuationChar(r) {
+			return false, false
+		}
+	}
+	return *d.punctuationOnlyResult, true
+}
@@ -0,0 +1,1007 @@
+package ischinese
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"unicode"
+)
+
+func TestWithPunctuationOnlyResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []Option
+		s         string
+		want      bool
+		wantPure  bool
+		wantRatio float64
+	}{
+		{
+			s:         "。，！",
+			want:      true,
+			wantPure:  true,
+			wantRatio: 1,
+		},
+		{
+			opts:      []Option{WithPunctuationOnlyResult(false)},
+			s:         "。，！",
+			want:      false,
+			wantPure:  false,
+			wantRatio: 0,
+		},
+		{
+			opts:      []Option{WithPunctuationOnlyResult(false)},
+			s:         "",
+			want:      true,
+			wantPure:  true,
+			wantRatio: 0,
+		},
+		{
+			opts:      []Option{WithPunctuationOnlyResult(false)},
+			s:         "你好！",
+			want:      true,
+			wantPure:  true,
+			wantRatio: 1,
+		},
+		{
+			opts:      []Option{WithPunctuationOnlyResult(true)},
+			s:         "hello!",
+			want:      false,
+			wantPure:  false,
+			wantRatio: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsChinese(tt.s); got != tt.want {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureChinese(tt.s); got != tt.wantPure {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.wantPure)
+			}
+			if got := d.IsSimplifiedChinese(tt.s); got != tt.want {
+				t.Errorf("IsSimplifiedChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.wantPure {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.wantPure)
+			}
+			if got := d.IsTraditionalChinese(tt.s); got != tt.want {
+				t.Errorf("IsTraditionalChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureTraditionalChinese(tt.s); got != tt.wantPure {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.wantPure)
+			}
+			if got := d.ChineseRatio(tt.s); got != tt.wantRatio {
+				t.Errorf("ChineseRatio() = %v, want %v", got, tt.wantRatio)
+			}
+		})
+	}
+}
+
+func TestWithStripFormatControls(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		s    string
+		want bool
+	}{
+		{
+			s:    "你­好​‪‬",
+			want: false,
+		},
+		{
+			opts: []Option{WithStripFormatControls(true)},
+			s:    "你­好​‪‬",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsPureChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsChinese(tt.s); got != tt.want {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCleanInvisible(t *testing.T) {
+	// zero width characters outnumber the ideographs
+	s := "\uFEFF你\u200B\u200D好\u200B\u200C\u2060"
+	tests := []struct {
+		name           string
+		opts           []Option
+		want           bool
+		wantSimplified bool
+	}{
+		{
+			want:           false,
+			wantSimplified: false,
+		},
+		{
+			opts:           []Option{WithCleanInvisible(true)},
+			want:           true,
+			wantSimplified: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsChinese(s); got != tt.want {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureChinese(s); got != tt.want {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsSimplifiedChinese(s); got != tt.wantSimplified {
+				t.Errorf("IsSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+		})
+	}
+}
+func TestWithStrictIdeographs(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		s    string
+		want bool
+	}{
+		{
+			s:    "㎡，你好",
+			want: true,
+		},
+		{
+			opts: []Option{WithStrictIdeographs(true)},
+			s:    "㎡，你好",
+			want: false,
+		},
+		{
+			opts: []Option{WithStrictIdeographs(true)},
+			s:    "你好\U00020000",
+			want: true,
+		},
+		{
+			opts: []Option{WithStrictIdeographs(true), WithStrictIdeographs(false)},
+			s:    "㎡，你好",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsPureChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithNormalizeRadicals(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		opts            []Option
+		s               string
+		wantTraditional bool
+		wantSimplified  bool
+	}{
+		{
+			s:               "⾨",
+			wantTraditional: false,
+			wantSimplified:  false,
+		},
+		{
+			opts:            []Option{WithNormalizeRadicals(true)},
+			s:               "⾨",
+			wantTraditional: true,
+			wantSimplified:  false,
+		},
+		{
+			opts:            []Option{WithNormalizeRadicals(true)},
+			s:               "⻳",
+			wantTraditional: false,
+			wantSimplified:  true,
+		},
+		{
+			opts:            []Option{WithNormalizeRadicals(true)},
+			s:               "⼈",
+			wantTraditional: true,
+			wantSimplified:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsPureTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+		})
+	}
+}
+
+func TestWithExcludeCodeSpans(t *testing.T) {
+	s := "用 `go build -o bin/app` 编译"
+	if NewDetector().IsChinese(s) {
+		t.Errorf("IsChinese() = true, want false")
+	}
+	if !NewDetector(WithExcludeCodeSpans(true)).IsChinese(s) {
+		t.Errorf("IsChinese() with WithExcludeCodeSpans = false, want true")
+	}
+	// code only
+	d := NewDetector(WithExcludeCodeSpans(true))
+	if s := "`rm -rf /`"; d.IsChinese(s) || d.IsPureChinese(s) || d.IsSimplifiedChinese(s) || d.IsPureTraditionalChinese(s) {
+		t.Errorf("%q detected as Chinese with WithExcludeCodeSpans, want not Chinese", s)
+	}
+}
+
+func TestWithFullwidthLatin(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		s    string
+		want bool
+	}{
+		{
+			s:    "ＡＢ你好",
+			want: false,
+		},
+		{
+			opts: []Option{WithFullwidthLatin(true)},
+			s:    "ＡＢ你好",
+			want: true,
+		},
+		{
+			opts: []Option{WithFullwidthLatin(true)},
+			s:    "AB你好",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsPureChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithUnicodeScriptProperty(t *testing.T) {
+	ranges := NewDetector()
+	property := NewDetector(WithUnicodeScriptProperty(true))
+	tests := []struct {
+		name         string
+		r            rune
+		wantRanges   bool
+		wantProperty bool
+	}{
+		{
+			r:            '中',
+			wantRanges:   true,
+			wantProperty: true,
+		},
+		{
+			r:            '，',
+			wantRanges:   true,
+			wantProperty: true,
+		},
+		{
+			r:            '㎡',
+			wantRanges:   true,
+			wantProperty: true,
+		},
+		{
+			r:            'a',
+			wantRanges:   false,
+			wantProperty: false,
+		},
+		{
+			r:            '\u2F00', // Kangxi radical ⼀
+			wantRanges:   false,
+			wantProperty: true,
+		},
+		{
+			r:            '\u9FFF', // newer than the ranges
+			wantRanges:   false,
+			wantProperty: true,
+		},
+		{
+			r:            '\uFA6E', // unassigned
+			wantRanges:   true,
+			wantProperty: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ranges.isChineseChar(tt.r); got != tt.wantRanges {
+				t.Errorf("isChineseChar(%U) with ranges = %v, want %v", tt.r, got, tt.wantRanges)
+			}
+			if got := property.isChineseChar(tt.r); got != tt.wantProperty {
+				t.Errorf("isChineseChar(%U) with unicode script property = %v, want %v", tt.r, got, tt.wantProperty)
+			}
+		})
+	}
+
+	var onlyRanges, onlyProperty int
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		a, b := ranges.isChineseChar(r), property.isChineseChar(r)
+		if a && !b {
+			onlyRanges++
+		}
+		if b && !a {
+			onlyProperty++
+		}
+	}
+	t.Logf("%d code points only in ranges, %d only in unicode script property", onlyRanges, onlyProperty)
+}
+
+func TestWithRelatedVariants(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		opts            []Option
+		r               rune
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			name:            "default",
+			r:               '説',
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+		{
+			name:            "z-variant of traditional",
+			opts:            []Option{WithRelatedVariants(true)},
+			r:               '説',
+			wantSimplified:  false,
+			wantTraditional: true,
+		},
+		{
+			name:            "in variant dictionary",
+			opts:            []Option{WithRelatedVariants(true)},
+			r:               '说',
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+		{
+			name:            "no related variant",
+			opts:            []Option{WithRelatedVariants(true)},
+			r:               '中',
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(tt.opts...)
+			if got := d.IsSimplifiedChineseRune(tt.r); got != tt.wantSimplified {
+				t.Errorf("IsSimplifiedChineseRune() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := d.IsTraditionalChineseRune(tt.r); got != tt.wantTraditional {
+				t.Errorf("IsTraditionalChineseRune() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+func TestWithVariantFile(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	// 人 as simplified variant of 亻, for the test only
+	d := NewDetector(WithVariantFile(strings.NewReader("# custom\nU+4EBA\tkTraditionalVariant\tU+4EBB\n")))
+	if err := d.Err(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name            string
+		s               string
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			s:               "人",
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+		{
+			s:               "亻",
+			wantSimplified:  false,
+			wantTraditional: true,
+		},
+		{
+			s:               "国",
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := d.IsPureTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+	if !IsPureTraditionalChinese("人") {
+		t.Errorf("WithVariantFile changed the package dictionaries")
+	}
+}
+
+func TestWithVariantFileError(t *testing.T) {
+	errRead := errors.New("read error")
+	d := NewDetector(WithVariantFile(iotest.ErrReader(errRead)))
+	if err := d.Err(); !errors.Is(err, errRead) {
+		t.Errorf("Err() = %v, want %v", err, errRead)
+	}
+}
+
+func TestAddVariant(t *testing.T) {
+	d := NewDetector()
+	if err := d.AddVariant('人', '亻'); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.IsPureTraditionalChinese("人"); got {
+		t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, false)
+	}
+	if got := d.IsPureTraditionalChinese("亻"); !got {
+		t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, true)
+	}
+	if !IsPureTraditionalChinese("人") {
+		t.Errorf("AddVariant changed the package dictionaries")
+	}
+}
+
+func TestDefaultUnchanged(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	if err := Default().AddVariant('人', '亻'); !errors.Is(err, errDefaultDetector) {
+		t.Errorf("AddVariant() = %v, want %v", err, errDefaultDetector)
+	}
+	if err := Default().LoadVariants(strings.NewReader("U+4EBA\tkTraditionalVariant\tU+4EBB\n")); !errors.Is(err, errDefaultDetector) {
+		t.Errorf("LoadVariants() = %v, want %v", err, errDefaultDetector)
+	}
+	// package level functions agree with each other
+	s := "人"
+	if !IsPureTraditionalChinese(s) || !IsTraditionalChineseBytes([]byte(s)) || KeepTraditionalChinese(s) != s || ToSimplified(s) != s {
+		t.Errorf("package level functions disagree on %q after changing Default", s)
+	}
+}
+
+func TestDetectorConvert(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	d := NewDetector()
+	if err := d.AddVariant('人', '亻'); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.ToTraditional("人们"), "亻們"; got != want {
+		t.Errorf("ToTraditional() = %q, want %q", got, want)
+	}
+	if got, want := d.ToSimplified("亻們"), "人们"; got != want {
+		t.Errorf("ToSimplified() = %q, want %q", got, want)
+	}
+	if got, want := ToTraditional("人们"), "人們"; got != want {
+		t.Errorf("package ToTraditional() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadVariantsError(t *testing.T) {
+	d := NewDetector()
+	errRead := errors.New("read error")
+	if err := d.LoadVariants(iotest.ErrReader(errRead)); !errors.Is(err, errRead) {
+		t.Errorf("LoadVariants() = %v, want %v", err, errRead)
+	}
+}
+
+// TestDetectorConcurrentUpdate run with -race
+func TestDetectorConcurrentUpdate(t *testing.T) {
+	d := NewDetector()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.IsSimplifiedChinese("简体中文")
+				d.IsTraditionalChineseReader(strings.NewReader("繁體中文"))
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.AddVariant(rune(0xE000+i*100+j), rune(0xF0000+i*100+j))
+			}
+			if err := d.LoadVariants(strings.NewReader("U+4EBA\tkTraditionalVariant\tU+4EBB\n")); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if got := d.IsPureSimplifiedChinese("人"); !got {
+		t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, true)
+	}
+}
+
+func TestDetectorASCII(t *testing.T) {
+	d := NewDetector()
+	for _, s := range []string{"", "a", "hello world", "2021-01-01", "`code` and *emphasis*"} {
+		for _, ratio := range []float64{-1, 0, 0.5, 1} {
+			if got, want := d.IsChineseWithThreshold(s, ratio), IsMostly(s, d.isChineseChar, ratio); got != want {
+				t.Errorf("IsChineseWithThreshold(%q, %v) = %v, want %v", s, ratio, got, want)
+			}
+			if got, want := d.IsSimplifiedChineseWithThreshold(s, ratio), IsMostly(s, d.isSimplifiedChineseChar, ratio); got != want {
+				t.Errorf("IsSimplifiedChineseWithThreshold(%q, %v) = %v, want %v", s, ratio, got, want)
+			}
+			if got, want := d.IsTraditionalChineseWithThreshold(s, ratio), IsMostly(s, d.isTraditionalChineseChar, ratio); got != want {
+				t.Errorf("IsTraditionalChineseWithThreshold(%q, %v) = %v, want %v", s, ratio, got, want)
+			}
+		}
+		if got, want := d.IsPureChinese(s), IsAll(s, d.isChineseChar); got != want {
+			t.Errorf("IsPureChinese(%q) = %v, want %v", s, got, want)
+		}
+		if got, want := d.IsPureSimplifiedChinese(s), IsAll(s, d.isSimplifiedChineseChar); got != want {
+			t.Errorf("IsPureSimplifiedChinese(%q) = %v, want %v", s, got, want)
+		}
+		if got, want := d.IsPureTraditionalChinese(s), IsAll(s, d.isTraditionalChineseChar); got != want {
+			t.Errorf("IsPureTraditionalChinese(%q) = %v, want %v", s, got, want)
+		}
+	}
+	// prepared to empty string
+	if got := NewDetector(WithExcludeCodeSpans(true)).IsChinese("`code`"); got {
+		t.Errorf("IsChinese() = %v, want %v", got, false)
+	}
+}
+
+func BenchmarkDetectorASCII(b *testing.B) {
+	d := NewDetector()
+	s := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 100)
+	b.Run("fast path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.IsChinese(s)
+		}
+	})
+	b.Run("rune loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			IsMostly(s, d.isChineseChar, 0.5)
+		}
+	})
+}
+
+func TestWithRanges(t *testing.T) {
+	// CJK Unified Ideographs without extensions, symbols and punctuation
+	d := NewDetector(WithRanges([][]rune{{'一', '鿼'}}))
+	if err := d.Err(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		s           string
+		want        bool
+		wantDefault bool
+	}{
+		{
+			s:           "中文",
+			want:        true,
+			wantDefault: true,
+		},
+		{
+			s:           "，。！",
+			want:        false,
+			wantDefault: true,
+		},
+		{
+			s:           "\U00020000\U00020001",
+			want:        false,
+			wantDefault: true,
+		},
+		{
+			s:           "hello",
+			want:        false,
+			wantDefault: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.IsPureChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.want)
+			}
+			if got := IsPureChinese(tt.s); got != tt.wantDefault {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.wantDefault)
+			}
+		})
+	}
+
+	if got := NewDetector(WithRanges([][]rune{{'z', 'a'}, {'a', 'z'}})).Err(); !errors.Is(got, errInvalidRange) {
+		t.Errorf("Err() = %v, want %v", got, errInvalidRange)
+	}
+	// ASCII ranges disable the ASCII fast path
+	latin := NewDetector(WithRanges([][]rune{{'a', 'z'}, {'一', '鿼'}}))
+	if got := latin.IsPureChinese("abc"); !got {
+		t.Errorf("IsPureChinese() = %v, want %v", got, true)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != Default() {
+		t.Errorf("Default() returned different Detectors")
+	}
+	// 人 as simplified variant of 亻 in one, 亻 as simplified variant of 人 in the other, for the test only
+	a := NewDetector(WithVariantFile(strings.NewReader("U+4EBA\tkTraditionalVariant\tU+4EBB\n")))
+	b := NewDetector(WithVariantFile(strings.NewReader("U+4EBB\tkTraditionalVariant\tU+4EBA\n")))
+	tests := []struct {
+		name string
+		d    *Detector
+		s    string
+		want bool
+	}{
+		{
+			name: "a",
+			d:    a,
+			s:    "人",
+			want: false,
+		},
+		{
+			name: "a",
+			d:    a,
+			s:    "亻",
+			want: true,
+		},
+		{
+			name: "b",
+			d:    b,
+			s:    "人",
+			want: true,
+		},
+		{
+			name: "b",
+			d:    b,
+			s:    "亻",
+			want: false,
+		},
+		{
+			name: "default",
+			d:    Default(),
+			s:    "人",
+			want: true,
+		},
+		{
+			name: "default",
+			d:    Default(),
+			s:    "亻",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.IsPureTraditionalChinese(tt.s); got != tt.want {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	b.AddVariant('天', '\U00020000')
+	if got := b.IsPureTraditionalChinese("天"); got {
+		t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, false)
+	}
+	if got := a.IsPureTraditionalChinese("天"); !got {
+		t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, true)
+	}
+}
+
+// TestDetectorMethods check the options of the Detector apply to the methods of package level functions without other options
+func TestDetectorMethods(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	// CJK Unified Ideographs and ASCII letters
+	ranges := NewDetector(WithRanges([][]rune{{'a', 'z'}, {'一', '鿼'}}))
+	custom := NewDetector()
+	if err := custom.AddVariant('人', '亻'); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		got         bool
+		want        bool
+		wantDefault bool
+	}{
+		{
+			name:        "IsChineseBytes",
+			got:         ranges.IsChineseBytes([]byte("abc")),
+			want:        true,
+			wantDefault: IsChineseBytes([]byte("abc")),
+		},
+		{
+			name:        "IsSimplifiedChineseBytes",
+			got:         custom.IsSimplifiedChineseBytes([]byte("亻")),
+			want:        false,
+			wantDefault: IsSimplifiedChineseBytes([]byte("亻")),
+		},
+		{
+			name:        "IsChineseIgnorePunctuation",
+			got:         ranges.IsChineseIgnorePunctuation("ok，"),
+			want:        true,
+			wantDefault: IsChineseIgnorePunctuation("ok，"),
+		},
+		{
+			name:        "IsChineseIdeographsOnly",
+			got:         ranges.IsChineseIdeographsOnly("\U00020000\U00020001"),
+			want:        false,
+			wantDefault: IsChineseIdeographsOnly("\U00020000\U00020001"),
+		},
+		{
+			name:        "IsNearlyPureChinese",
+			got:         ranges.IsNearlyPureChinese("中文ok", 0),
+			want:        true,
+			wantDefault: IsNearlyPureChinese("中文ok", 0),
+		},
+		{
+			name:        "ContainsChinese",
+			got:         ranges.ContainsChinese("，"),
+			want:        false,
+			wantDefault: ContainsChinese("，"),
+		},
+		{
+			name:        "ContainsTraditionalChinese",
+			got:         custom.ContainsTraditionalChinese("人"),
+			want:        false,
+			wantDefault: ContainsTraditionalChinese("人"),
+		},
+		{
+			name:        "Classify",
+			got:         custom.Classify("人人") == ScriptSimplified,
+			want:        true,
+			wantDefault: Classify("人人") == ScriptSimplified,
+		},
+		{
+			name:        "Analyze",
+			got:         custom.Analyze("人").SimplifiedOnly == 1,
+			want:        true,
+			wantDefault: Analyze("人").SimplifiedOnly == 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+			if tt.wantDefault == tt.want {
+				t.Errorf("package %s() = %v, want the option to make a difference", tt.name, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	d := NewDetector(WithCache(2))
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			name: "miss chinese",
+			s:    "你好",
+			want: true,
+		},
+		{
+			name: "miss ascii",
+			s:    "hello",
+			want: false,
+		},
+		{
+			name: "hit",
+			s:    "你好",
+			want: true,
+		},
+		{
+			name: "evicts hello",
+			s:    "hello 世界",
+			want: false,
+		},
+		{
+			name: "evicts 你好",
+			s:    "世界",
+			want: true,
+		},
+		{
+			name: "evicted hello added again",
+			s:    "hello",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// miss, then hit
+			for i := 0; i < 2; i++ {
+				if got := d.IsChinese(tt.s); got != tt.want {
+					t.Errorf("IsChinese(%q) = %v, want %v", tt.s, got, tt.want)
+				}
+			}
+			if got, ok := d.cache.get(tt.s); !ok || got != tt.want {
+				t.Errorf("cache.get(%q) = %v, %v, want %v, %v", tt.s, got, ok, tt.want, true)
+			}
+			if got := d.cache.len(); got > 2 {
+				t.Errorf("cache.len() = %v, want at most %v", got, 2)
+			}
+		})
+	}
+	// least recently used evicted
+	if _, ok := d.cache.get("你好"); ok {
+		t.Errorf("cache.get(%q) hit, want evicted", "你好")
+	}
+	if NewDetector().cache != nil || NewDetector(WithCache(0)).cache != nil || Default().cache != nil {
+		t.Errorf("cache enabled, want disabled by default")
+	}
+}
+
+// BenchmarkWithCache tags drawn from a skewed distribution, a few of them make up most of the calls
+func BenchmarkWithCache(b *testing.B) {
+	tags := make([]string, 1000)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("中文标签 %d tag", i)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rnd, 1.2, 1, uint64(len(tags)-1))
+	inputs := make([]string, 4096)
+	for i := range inputs {
+		inputs[i] = tags[zipf.Uint64()]
+	}
+	for _, bm := range []struct {
+		name string
+		d    *Detector
+	}{
+		{"uncached", NewDetector()},
+		{"cached", NewDetector(WithCache(100))},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bm.d.IsChinese(inputs[i%len(inputs)])
+			}
+		})
+	}
+}
+
+func TestIgnoreWhitespaceAndDigits(t *testing.T) {
+	d := NewDetector(IgnoreWhitespaceAndDigits(true))
+	tests := []struct {
+		name        string
+		s           string
+		want        bool
+		wantPure    bool
+		wantDefault bool
+	}{
+		{
+			s:           "你好 2021",
+			want:        true,
+			wantPure:    true,
+			wantDefault: false,
+		},
+		{
+			s:           "  你好  ",
+			want:        true,
+			wantPure:    true,
+			wantDefault: false,
+		},
+		{
+			s:           "第 1 2 3 章",
+			want:        true,
+			wantPure:    true,
+			wantDefault: false,
+		},
+		{
+			s:           "你好 2021 hello",
+			want:        false,
+			wantPure:    false,
+			wantDefault: false,
+		},
+		{
+			s:           "你好　１２",
+			want:        true,
+			wantPure:    true,
+			wantDefault: true,
+		},
+		{
+			s:           "2021",
+			want:        false,
+			wantPure:    false,
+			wantDefault: false,
+		},
+		{
+			s:           "   ",
+			want:        false,
+			wantPure:    false,
+			wantDefault: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.IsChinese(tt.s); got != tt.want {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.want)
+			}
+			if got := d.IsPureChinese(tt.s); got != tt.wantPure {
+				t.Errorf("IsPureChinese() = %v, want %v", got, tt.wantPure)
+			}
+			if got := IsChinese(tt.s); got != tt.wantDefault {
+				t.Errorf("default IsChinese() = %v, want %v", got, tt.wantDefault)
+			}
+		})
+	}
+	if got, want := d.ChineseRatio("你好 2021"), 1.0; got != want {
+		t.Errorf("ChineseRatio() = %v, want %v", got, want)
+	}
+	// whitespace and digits only
+	for _, s := range []string{"2021", "   "} {
+		if d.IsSimplifiedChinese(s) || d.IsTraditionalChinese(s) || d.IsPureSimplifiedChinese(s) || d.IsPureTraditionalChinese(s) {
+			t.Errorf("%q detected as simplified or traditional Chinese, want neither", s)
+		}
+	}
+}
+
+func TestNewDetectorFromReader(t *testing.T) {
+	data := "# variants of 国 and 发 only\n" +
+		"U+56FD\tkTraditionalVariant\tU+570B\n" +
+		"U+570B\tkSimplifiedVariant\tU+56FD\n" +
+		"U+53D1\tkTraditionalVariant\tU+767C U+9AEE\n"
+	d, err := NewDetectorFromReader(strings.NewReader(data), WithPunctuationOnlyResult(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name            string
+		s               string
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			s:               "国",
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+		{
+			s:               "國髮",
+			wantSimplified:  false,
+			wantTraditional: true,
+		},
+		// not in the dictionaries, so shared
+		{
+			s:               "这個",
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.IsPureSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := d.IsPureTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+	if got := d.IsChinese("。，"); got {
+		t.Errorf("IsChinese() = %v, want %v", got, false)
+	}
+	if _, err := NewDetectorFromReader(strings.NewReader("# no variants\n")); !errors.Is(err, errEmptyDictionary) {
+		t.Errorf("NewDetectorFromReader() error = %v, want %v", err, errEmptyDictionary)
+	}
+	errRead := errors.New("read error")
+	if _, err := NewDetectorFromReader(iotest.ErrReader(errRead)); !errors.Is(err, errRead) {
+		t.Errorf("NewDetectorFromReader() error = %v, want %v", err, errRead)
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !ischinese_noembed
+// +build !ischinese_noembed
+
+package ischinese
+
+import "embed"
+
+// hasEmbeddedDictionary true unless built with the tag ischinese_noembed, see NewDetectorFromReader
+const hasEmbeddedDictionary = true
+
+//go:embed Unihan_Variants.txt
+var embedded embed.FS
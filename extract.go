@@ -0,0 +1,135 @@
+package ischinese
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// hanRunes return the CJK ideographs of s, dropping punctuation, symbols and non Chinese code points
+func hanRunes(s string) []rune {
+	var res []rune
+	for _, r := range s {
+		if isIdeographChar(r) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// LongestCommonChineseSubstring return the longest contiguous run of CJK ideographs shared by a and b,
+// after dropping everything but ideographs from both, so "你好，世界" and "你好世界" share "你好世界".
+// It takes O(len(a)*len(b)) time and O(len(b)) memory in runes, ties return the run ending first in a.
+func LongestCommonChineseSubstring(a, b string) string {
+	ra, rb := hanRunes(a), hanRunes(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	var best, end int
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			if ra[i-1] == rb[j-1] {
+				cur[j] = prev[j-1] + 1
+				if cur[j] > best {
+					best, end = cur[j], i
+				}
+			} else {
+				cur[j] = 0
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return string(ra[end-best : end])
+}
+
+// bracketPairs map opening brackets to closing ones
+var bracketPairs = map[rune]rune{
+	'(': ')',
+	'[': ']',
+	'（': '）',
+	'［': '］',
+	'【': '】',
+	'「': '」',
+	'『': '』',
+	'《': '》',
+	'〈': '〉',
+	'〔': '〕',
+}
+
+// ChineseInBrackets return the CJK ideographs inside each outermost pair of CJK or ASCII brackets, e.g. ["中文"] for "term（中文）".
+// Nested brackets are part of the outer pair, closing brackets which match no opening bracket are ignored,
+// unclosed brackets are dropped, and pairs without ideographs are skipped.
+func ChineseInBrackets(s string) []string {
+	type open struct {
+		closing rune
+		start   int
+	}
+	var res []string
+	var stack []open
+	for i, r := range s {
+		if closing, ok := bracketPairs[r]; ok {
+			stack = append(stack, open{closing: closing, start: i + utf8.RuneLen(r)})
+			continue
+		}
+		if len(stack) == 0 || stack[len(stack)-1].closing != r {
+			continue
+		}
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if len(stack) > 0 {
+			continue
+		}
+		if han := hanRunes(s[top.start:i]); len(han) > 0 {
+			res = append(res, string(han))
+		}
+	}
+	return res
+}
+
+// ChineseRunes return the Chinese unicode code points of s in order, with duplicates
+func ChineseRunes(s string) []rune {
+	var res []rune
+	for _, r := range s {
+		if isChineseChar(r) {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// UniqueChineseRunes return the distinct Chinese unicode code points of s in ascending order
+func UniqueChineseRunes(s string) []rune {
+	res := ChineseRunes(s)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	unique := res[:0]
+	for _, r := range res {
+		if len(unique) == 0 || r != unique[len(unique)-1] {
+			unique = append(unique, r)
+		}
+	}
+	return unique
+}
+
+// LongestChineseRun return the longest run of consecutive Chinese unicode code points of s, counted in code points,
+// and its byte offset, e.g. "世界和平" and 13 for "你好 hello 世界和平 ok". Ties return the first run, no Chinese returns "" and -1.
+func LongestChineseRun(s string) (text string, start int) {
+	bestStart, bestEnd, bestLen := -1, -1, 0
+	curStart, curLen := 0, 0
+	for i, r := range s {
+		if !isChineseChar(r) {
+			curLen = 0
+			continue
+		}
+		if curLen == 0 {
+			curStart = i
+		}
+		curLen++
+		if curLen > bestLen {
+			bestStart, bestEnd, bestLen = curStart, i+utf8.RuneLen(r), curLen
+		}
+	}
+	if bestLen == 0 {
+		return "", -1
+	}
+	return s[bestStart:bestEnd], bestStart
+}
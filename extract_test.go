@@ -0,0 +1,169 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLongestCommonChineseSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			a:    "",
+			b:    "你好",
+			want: "",
+		},
+		{
+			a:    "hello world",
+			b:    "hello world",
+			want: "",
+		},
+		{
+			a:    "你好，世界",
+			b:    "他说：你好世界！",
+			want: "你好世界",
+		},
+		{
+			a:    "明朝那些事儿",
+			b:    "那些年，明朝",
+			want: "明朝",
+		},
+		{
+			a:    "abc天气不错def",
+			b:    "今天天气不错啊",
+			want: "天气不错",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestCommonChineseSubstring(tt.a, tt.b); got != tt.want {
+				t.Errorf("LongestCommonChineseSubstring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseInBrackets(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    "term（中文）",
+			want: []string{"中文"},
+		},
+		{
+			s:    "apple (苹果) and [香蕉] or 【橙子】(orange)",
+			want: []string{"苹果", "香蕉", "橙子"},
+		},
+		{
+			s:    "《外（内）层》",
+			want: []string{"外内层"},
+		},
+		{
+			s:    "）前（未闭合",
+			want: nil,
+		},
+		{
+			s:    "（中】文）",
+			want: []string{"中文"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChineseInBrackets(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChineseInBrackets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseRunes(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       []rune
+		wantUnique []rune
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello",
+		},
+		{
+			s:          "中文hello中国，\U00020000文",
+			want:       []rune{'中', '文', '中', '国', '，', '\U00020000', '文'},
+			wantUnique: []rune{'中', '国', '文', '，', '\U00020000'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChineseRunes(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChineseRunes() = %q, want %q", got, tt.want)
+			}
+			if got := UniqueChineseRunes(tt.s); !reflect.DeepEqual(got, tt.wantUnique) {
+				t.Errorf("UniqueChineseRunes() = %q, want %q", got, tt.wantUnique)
+			}
+		})
+	}
+}
+
+func TestLongestChineseRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		wantText  string
+		wantStart int
+	}{
+		{
+			s:         "",
+			wantText:  "",
+			wantStart: -1,
+		},
+		{
+			s:         "hello world",
+			wantText:  "",
+			wantStart: -1,
+		},
+		{
+			s:         "你好 hello 世界和平 ok 中文",
+			wantText:  "世界和平",
+			wantStart: 13,
+		},
+		// ties return the first run
+		{
+			s:         "你好 hello 世界",
+			wantText:  "你好",
+			wantStart: 0,
+		},
+		{
+			s:         "a中文，标点b",
+			wantText:  "中文，标点",
+			wantStart: 1,
+		},
+		// counted in code points, not bytes
+		{
+			s:         "\U00020000\U00020001 一二三",
+			wantText:  "一二三",
+			wantStart: 9,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotText, gotStart := LongestChineseRun(tt.s)
+			if gotText != tt.wantText || gotStart != tt.wantStart {
+				t.Errorf("LongestChineseRun() = %q, %v, want %q, %v", gotText, gotStart, tt.wantText, tt.wantStart)
+			}
+		})
+	}
+}
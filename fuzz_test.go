@@ -0,0 +1,83 @@
+//go:build go1.18
+// +build go1.18
+
+package ischinese
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzParseUnicodeString(f *testing.F) {
+	for _, s := range []string{"U+4E00", "U+20000", "4E00", "U+", "", "U+D800", "U+110000", "U+FFFFFFFF", "U+4E22<kHKGlyph", "U++4E00"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		r, err := parseUnicodeString(s)
+		if err != nil {
+			return
+		}
+		if !utf8.ValidRune(r) {
+			t.Fatalf("parseUnicodeString(%q) = %U, want a valid unicode code point", s, r)
+		}
+		if got, err := parseUnicodeString(fmt.Sprintf("U+%04X", r)); err != nil || got != r {
+			t.Fatalf("parseUnicodeString(%q) = %U, %v, want %U", fmt.Sprintf("U+%04X", r), got, err, r)
+		}
+	})
+}
+
+// fuzzSeeds ordinary text, invalid UTF-8, an encoded surrogate, noncharacters and a long string
+var fuzzSeeds = []string{
+	"",
+	"中文",
+	"hello 世界",
+	"喜欢锻炼的人，身体应该比较好",
+	"《射鵰英雄傳》\U00020000",
+	"。，！",
+	"\xff\xfe",
+	"\xed\xa0\x80",
+	"�￿\U0010FFFF",
+	strings.Repeat("中a", 1<<8),
+}
+
+func FuzzIsChinese(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if ratio := ChineseRatio(s); ratio < 0 || ratio > 1 {
+			t.Fatalf("ChineseRatio(%q) = %v, want in [0, 1]", s, ratio)
+		}
+		if count := CountChinese(s); count < 0 || count > utf8.RuneCountInString(s) {
+			t.Fatalf("CountChinese(%q) = %v, want in [0, %v]", s, count, utf8.RuneCountInString(s))
+		}
+		if IsPureChinese(s) && !IsChinese(s) {
+			t.Fatalf("IsPureChinese(%q) = true, but IsChinese() = false", s)
+		}
+		if got, want := IsChineseBytes([]byte(s)), IsChinese(s); got != want {
+			t.Fatalf("IsChineseBytes(%q) = %v, want %v", s, got, want)
+		}
+	})
+}
+
+func FuzzIsPureSimplifiedChinese(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if !IsPureSimplifiedChinese(s) {
+			return
+		}
+		if !IsPureChinese(s) {
+			t.Fatalf("IsPureSimplifiedChinese(%q) = true, but IsPureChinese() = false", s)
+		}
+		if !IsSimplifiedChinese(s) {
+			t.Fatalf("IsPureSimplifiedChinese(%q) = true, but IsSimplifiedChinese() = false", s)
+		}
+		if ratio := SimplifiedChineseRatio(s); ratio != 1 && s != "" {
+			t.Fatalf("IsPureSimplifiedChinese(%q) = true, but SimplifiedChineseRatio() = %v", s, ratio)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package ischinese
+
+import "strings"
+
+// glossarySeparators accepted between the Chinese and the non Chinese segment of a glossary line
+var glossarySeparators = []string{" - ", " – ", " — ", " | ", " = ", "\t", "：", ": "}
+
+// IsBilingualGlossaryLine true if s looks like "中文 - English": a Chinese segment, a separator and a non Chinese segment.
+// Accepted separators are " - ", " – ", " — ", " | ", " = ", tab, fullwidth colon "：" and ": ", the first one found splits the line.
+// It is a structural heuristic: the Chinese segment must be IsChinese and the other one must not.
+func IsBilingualGlossaryLine(s string) bool {
+	index, sep := -1, ""
+	for _, candidate := range glossarySeparators {
+		if i := strings.Index(s, candidate); i >= 0 && (index < 0 || i < index) {
+			index, sep = i, candidate
+		}
+	}
+	if index < 0 {
+		return false
+	}
+	chinese := strings.TrimSpace(s[:index])
+	other := strings.TrimSpace(s[index+len(sep):])
+	if chinese == "" || other == "" {
+		return false
+	}
+	return IsChinese(chinese) && !IsChinese(other)
+}
@@ -0,0 +1,53 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestIsBilingualGlossaryLine(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "中文 - Chinese",
+			want: true,
+		},
+		{
+			s:    "机器学习：machine learning",
+			want: true,
+		},
+		{
+			s:    "繁體\ttraditional Chinese",
+			want: true,
+		},
+		{
+			s:    "中文",
+			want: false,
+		},
+		{
+			s:    "Chinese - 中文",
+			want: false,
+		},
+		{
+			s:    "中文 - 汉语",
+			want: false,
+		},
+		{
+			s:    "中文 - ",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBilingualGlossaryLine(tt.s); got != tt.want {
+				t.Errorf("IsBilingualGlossaryLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
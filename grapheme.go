@@ -0,0 +1,30 @@
+package ischinese
+
+import "unicode"
+
+// isCombiningChar true for combining marks (unicode category M), including variation selectors,
+// which belong to the grapheme cluster of the unicode code point before them
+func isCombiningChar(r rune) bool {
+	return unicode.Is(unicode.M, r)
+}
+
+// baseRunes return the base unicode code point of each grapheme cluster of s, a base followed by its combining marks.
+// Combining marks without a base, at the start of s, are a cluster of their own.
+func baseRunes(s string) []rune {
+	var bases []rune
+	for i, r := range s {
+		if i > 0 && isCombiningChar(r) {
+			continue
+		}
+		bases = append(bases, r)
+	}
+	return bases
+}
+
+// IsChineseGraphemes true if more than 50% of grapheme clusters have a Chinese unicode base, so that combining marks,
+// e.g. the tone mark of a decomposed Pinyin annotation or a variation selector after an ideograph, do not distort the ratio.
+// A cluster is a base unicode code point followed by combining marks (unicode category M). It is a minimal approximation
+// of UAX #29 grapheme clusters, which also join Hangul syllables, emoji sequences and regional indicators.
+func IsChineseGraphemes(s string) bool {
+	return IsMostly(string(baseRunes(s)), isChineseChar, 0.5)
+}
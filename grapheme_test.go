@@ -0,0 +1,52 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestIsChineseGraphemes(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             string
+		want          bool
+		wantIsChinese bool
+	}{
+		{
+			s:             "",
+			want:          true,
+			wantIsChinese: true,
+		},
+		{
+			s:             "中文ab",
+			want:          false,
+			wantIsChinese: false,
+		},
+		{
+			// a and o with combining caron
+			s:             "中文字a\u030Co\u030C",
+			want:          true,
+			wantIsChinese: false,
+		},
+		{
+			// ideographs with variation selectors
+			s:             "葛\U000E0100辻\U000E0101a",
+			want:          true,
+			wantIsChinese: false,
+		},
+		{
+			s:             "\u0301中",
+			want:          false,
+			wantIsChinese: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseGraphemes(tt.s); got != tt.want {
+				t.Errorf("IsChineseGraphemes() = %v, want %v", got, tt.want)
+			}
+			if got := IsChinese(tt.s); got != tt.wantIsChinese {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.wantIsChinese)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package ischinese
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// data from Unihan_IRGSources.txt, which is not embedded for its size apart from radicalStrokeTable, see LoadIRGSources
+var (
+	irgMu       sync.RWMutex
+	iiCoreDict  map[rune]struct{}
+	radicalDict map[rune]int
+)
+
+// LoadIRGSources parse Unihan_IRGSources.txt (https://www.unicode.org/Public/UCD/latest/ucd/Unihan.zip),
+// replacing previously loaded data. The kIICore field enables the rare_char_ratio signal,
+// the kRSUnicode (radical-stroke) field replaces the embedded radical table of SameRadical, e.g. with a newer Unihan release.
+func LoadIRGSources(r io.Reader) error {
+	iiCore, radical, err := parseIRGSources(r)
+	if err != nil {
+		return err
+	}
+	irgMu.Lock()
+	defer irgMu.Unlock()
+	iiCoreDict = iiCore
+	radicalDict = radical
+	return nil
+}
+
+// parseIRGSources parse the kIICore and kRSUnicode fields of Unihan_IRGSources.txt
+func parseIRGSources(r io.Reader) (iiCore map[rune]struct{}, radical map[rune]int, err error) {
+	iiCore = make(map[rune]struct{})
+	radical = make(map[rune]int)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// skip comments
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		k, err := parseUnicodeString(fields[0])
+		if err != nil {
+			// eat err
+			continue
+		}
+		switch fields[1] {
+		case "kIICore":
+			iiCore[k] = struct{}{}
+		case "kRSUnicode":
+			// primary value only, e.g. "120'.3" is radical 120 in its simplified form plus 3 strokes
+			number := strings.TrimRight(strings.SplitN(fields[2], ".", 2)[0], "'")
+			n, err := strconv.Atoi(number)
+			if err != nil {
+				// eat err
+				continue
+			}
+			radical[k] = n
+		default:
+			continue
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return iiCore, radical, nil
+}
+
+// loadedIICore return the IICore set, nil if IRG data is not loaded. The set is replaced, never modified, on reload.
+func loadedIICore() map[rune]struct{} {
+	irgMu.RLock()
+	defer irgMu.RUnlock()
+	return iiCoreDict
+}
+
+// radicalStart first code point of a run of consecutive code points with the same radical, see radicalStrokeTable
+type radicalStart struct {
+	lo      rune
+	radical uint8
+}
+
+// radicalOf return the primary Kangxi radical of r, from LoadIRGSources if loaded, otherwise from radicalStrokeTable, 0 for none
+func radicalOf(r rune) int {
+	irgMu.RLock()
+	defer irgMu.RUnlock()
+	if len(radicalDict) > 0 {
+		return radicalDict[r]
+	}
+	i := sort.Search(len(radicalStrokeTable), func(i int) bool {
+		return radicalStrokeTable[i].lo > r
+	}) - 1
+	if i < 0 {
+		return 0
+	}
+	return int(radicalStrokeTable[i].radical)
+}
+
+// SameRadical true if both runes are Han characters with the same primary Kangxi radical in kRSUnicode,
+// a simplified radical form (e.g. 纟) counts as its Kangxi radical (糸), e.g. 红 and 紅.
+func SameRadical(a, b rune) bool {
+	ra := radicalOf(a)
+	return ra != 0 && ra == radicalOf(b)
+}
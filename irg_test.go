@@ -0,0 +1,169 @@
+package ischinese
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var irgSources = flag.String("irgsources", "", "regenerate "+radicalStrokeFile+" from the kRSUnicode field of this Unihan_IRGSources.txt")
+
+const radicalStrokeFile = "radicalstroke.go"
+
+// generateRadicalStrokeTable generate the source of radicalStrokeTable from primary Kangxi radicals by code point
+func generateRadicalStrokeTable(radicals map[rune]int) ([]byte, error) {
+	keys := make([]rune, 0, len(radicals))
+	for r := range radicals {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	var starts []radicalStart
+	for i, r := range keys {
+		if radicals[r] < 1 || radicals[r] > 214 {
+			return nil, fmt.Errorf("%U: radical %d out of range", r, radicals[r])
+		}
+		if i == 0 || keys[i-1] != r-1 || radicals[keys[i-1]] != radicals[r] {
+			if i > 0 && keys[i-1] != r-1 {
+				starts = append(starts, radicalStart{lo: keys[i-1] + 1})
+			}
+			starts = append(starts, radicalStart{lo: r, radical: uint8(radicals[r])})
+		}
+	}
+	if len(keys) > 0 {
+		starts = append(starts, radicalStart{lo: keys[len(keys)-1] + 1})
+	}
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go test -run TestRadicalStrokeTable -irgsources Unihan_IRGSources.txt; DO NOT EDIT.\n\n")
+	buf.WriteString("package ischinese\n\n")
+	buf.WriteString("// radicalStrokeTable primary Kangxi radical of CJK ideographs in kRSUnicode, a simplified radical form as its Kangxi radical.\n")
+	buf.WriteString("// Each entry covers the code points up to the next one, radical 0 for none.\n")
+	buf.WriteString("var radicalStrokeTable = []radicalStart{\n")
+	for i, start := range starts {
+		fmt.Fprintf(&buf, "{0x%04X, %d},", start.lo, start.radical)
+		if i%6 == 5 || i == len(starts)-1 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// TestRadicalStrokeTable check radicalStrokeTable against the Kangxi Radicals, -irgsources regenerates it
+func TestRadicalStrokeTable(t *testing.T) {
+	if *irgSources != "" {
+		f, err := os.Open(*irgSources)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		_, radicals, err := parseIRGSources(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		src, err := generateRadicalStrokeTable(radicals)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(radicalStrokeFile, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	for i := 1; i < len(radicalStrokeTable); i++ {
+		if radicalStrokeTable[i-1].lo >= radicalStrokeTable[i].lo {
+			t.Fatalf("radicalStrokeTable not sorted at %U", radicalStrokeTable[i].lo)
+		}
+	}
+	// each Kangxi radical is its own radical, e.g. ⼈ U+2F08 is 人, radical 9
+	for r := rune(0x2F00); r <= 0x2FD5; r++ {
+		if got, want := radicalOf(radicalIdeographs[r]), int(r-0x2F00+1); got != want {
+			t.Errorf("radicalOf(%c) = %v, want %v", radicalIdeographs[r], got, want)
+		}
+	}
+}
+
+const irgSourcesSample = `# Unihan_IRGSources.txt
+U+4E00	kIICore	AGTJHKMP
+U+4E00	kRSUnicode	1.0
+U+4E01	kRSUnicode	1.1
+U+4E2D	kRSUnicode	2.3
+U+4EBA	kIICore	AGTJHKMP
+U+4EBA	kRSUnicode	9.0
+U+4F60	kRSUnicode	9.5
+U+7CF8	kRSUnicode	120.0
+U+7EA2	kRSUnicode	120'.3
+U+7D05	kRSUnicode	120.3 120.4
+`
+
+func TestSameRadical(t *testing.T) {
+	// embedded radicalStrokeTable
+	for _, pair := range []string{"人你", "红紅", "中中", "语說"} {
+		a, b := []rune(pair)[0], []rune(pair)[1]
+		if !SameRadical(a, b) {
+			t.Errorf("SameRadical(%c, %c) = false before LoadIRGSources, want true", a, b)
+		}
+	}
+	if SameRadical('人', '中') || SameRadical('a', 'a') {
+		t.Errorf("SameRadical() = true before LoadIRGSources, want false")
+	}
+	defer func() {
+		iiCoreDict = nil
+		radicalDict = nil
+	}()
+	if err := LoadIRGSources(strings.NewReader(irgSourcesSample)); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		a    rune
+		b    rune
+		want bool
+	}{
+		{
+			a:    '人',
+			b:    '你',
+			want: true,
+		},
+		{
+			a:    '一',
+			b:    '丁',
+			want: true,
+		},
+		{
+			a:    '一',
+			b:    '人',
+			want: false,
+		},
+		{
+			a:    '红',
+			b:    '紅',
+			want: true,
+		},
+		{
+			a:    '糸',
+			b:    'a',
+			want: false,
+		},
+		{
+			a:    '中',
+			b:    '中',
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameRadical(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameRadical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
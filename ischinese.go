@@ -2,143 +2,386 @@ package ischinese
 
 import (
 	"bufio"
-	"embed"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"log"
+	"os"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
 )
 
-var debugFlag = false
+// debugFlag 1 to log unicode code points which do not match, accessed atomically
+var debugFlag int32
+
+var debugMu sync.RWMutex
+
+// debugLogger nil for the standard logger
+var debugLogger *log.Logger
+
+// SetDebug enable or disable logging unicode code points which do not match during detection.
+// It is global state shared by all Detectors and safe for concurrent use.
+func SetDebug(enabled bool) {
+	var flag int32
+	if enabled {
+		flag = 1
+	}
+	atomic.StoreInt32(&debugFlag, flag)
+}
+
+// SetDebugLogger route debug output to logger, nil for the standard logger of package log.
+// It is global state shared by all Detectors and safe for concurrent use.
+func SetDebugLogger(logger *log.Logger) {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	debugLogger = logger
+}
 
 func debug(v ...interface{}) {
-	if debugFlag {
+	if atomic.LoadInt32(&debugFlag) == 0 {
+		return
+	}
+	debugMu.RLock()
+	logger := debugLogger
+	debugMu.RUnlock()
+	if logger == nil {
 		log.Println(v...)
+		return
 	}
+	logger.Println(v...)
+}
+
+// ideographBlock unicode block of CJK ideographs and its range of Chinese unicode, which may end before the block does
+type ideographBlock struct {
+	name   string
+	lo, hi rune
 }
 
-var commonRange = [][]rune{
+// ideographBlocks the ranges of ideographRange by block, see DominantIdeographBlock
+var ideographBlocks = []ideographBlock{
 	// https://en.wikipedia.org/wiki/CJK_Unified_Ideographs
+	{"CJK Unified Ideographs", '\u4E00', '\u9FFC'},
+	{"CJK Unified Ideographs Extension A", '\u3400', '\u4DBF'},
+	{"CJK Unified Ideographs Extension B", '\U00020000', '\U0002A6DD'},
+	{"CJK Unified Ideographs Extension C", '\U0002A700', '\U0002B734'},
+	{"CJK Unified Ideographs Extension D", '\U0002B740', '\U0002B81D'},
+	{"CJK Unified Ideographs Extension E", '\U0002B820', '\U0002CEA1'},
+	{"CJK Unified Ideographs Extension F", '\U0002CEB0', '\U0002EBE0'},
+	{"CJK Unified Ideographs Extension G", '\U00030000', '\U0003134F'},
+	// https://en.wikipedia.org/wiki/CJK_Compatibility_Ideographs
+	// the whole block, including the twelve unified ideographs U+FA0E, U+FA0F, U+FA11, U+FA13, U+FA14, U+FA1F,
+	// U+FA21, U+FA23, U+FA24 and U+FA27–U+FA29, and the unassigned code points U+FA6E, U+FA6F and U+FADA–U+FAFF
+	{"CJK Compatibility Ideographs", '\uF900', '\uFAFF'},
+	// https://en.wikipedia.org/wiki/CJK_Compatibility_Ideographs_Supplement
+	{"CJK Compatibility Ideographs Supplement", '\U0002F800', '\U0002FA1F'},
+}
+
+var ideographRange = blockRanges(ideographBlocks)
+
+func blockRanges(blocks []ideographBlock) [][]rune {
+	res := make([][]rune, 0, len(blocks))
+	for _, block := range blocks {
+		res = append(res, []rune{block.lo, block.hi})
+	}
+	return res
+}
+
+var symbolRange = [][]rune{
 	{
-		'\u4E00', '\u9FFC', // CJK Unified Ideographs
-	},
-	{
-		'\u3400', '\u4DBF', // CJK Unified Ideographs Extension A
-	},
-	{
-		'\U00020000', '\U0002A6DD', // CJK Unified Ideographs Extension B
-	},
-	{
-		'\U0002A700', '\U0002B734', // CJK Unified Ideographs Extension C
-	},
-	{
-		'\U0002B740', '\U0002B81D', // CJK Unified Ideographs Extension D
-	},
-	{
-		'\U0002B820', '\U0002CEA1', // CJK Unified Ideographs Extension E
-	},
-	{
-		'\U0002CEB0', '\U0002EBE0', // CJK Unified Ideographs Extension F
-	},
-	{
-		'\U00030000', '\U0003134F', // CJK Unified Ideographs Extension G
+		'\u3300', '\u33FF', // Other CJK ideographs in Unicode, not Unified
 	},
+}
+
+var punctuationRange = [][]rune{
+	// https://en.wikipedia.org/wiki/CJK_Symbols_and_Punctuation
 	{
-		'\uFA0E', '\uFA0F', // CJK Compatibility Ideographs
+		'\u3000', '\u303F',
 	},
+	// https://en.wikipedia.org/wiki/Chinese_punctuation
 	{
-		'\uFA11', '\uFA11', // CJK Compatibility Ideographs
+		'\uFF0C',
+		'\uFF0C',
 	},
 	{
-		'\uFA13', '\uFA14', // CJK Compatibility Ideographs
+		'\uFF01',
+		'\uFF01',
 	},
 	{
-		'\uFA1F', '\uFA1F', // CJK Compatibility Ideographs
+		'\uFF1F',
+		'\uFF1F',
 	},
 	{
-		'\uFA21', '\uFA21', // CJK Compatibility Ideographs
+		'\uFF1A',
+		'\uFF1B',
 	},
 	{
-		'\uFA23', '\uFA24', // CJK Compatibility Ideographs
+		'\uFF08',
+		'\uFF09',
 	},
 	{
-		'\uFA27', '\uFA29', // CJK Compatibility Ideographs
+		'\uFF3B',
+		'\uFF3B',
 	},
 	{
-		'\u3300', '\u33FF', // Other CJK ideographs in Unicode, not Unified
+		'\uFF3D',
+		'\uFF3D',
 	},
 	{
-		'\uFE30', '\uFE4F', // Other CJK ideographs in Unicode, not Unified
+		'\u3010',
+		'\u3011',
 	},
+	// https://en.wikipedia.org/wiki/Vertical_Forms
 	{
-		'\uF900', '\uFAFF', // Other CJK ideographs in Unicode, not Unified
+		'\uFE10', '\uFE19', // vertical comma, ideographic full stop, brackets and ellipsis
 	},
+	// https://en.wikipedia.org/wiki/CJK_Compatibility_Forms
 	{
-		'\U0002F800', '\U0002FA1F', // Other CJK ideographs in Unicode, not Unified
+		'\uFE30', '\uFE4F', // vertical punctuation, brackets, overlines and low lines
 	},
-	// https://en.wikipedia.org/wiki/CJK_Symbols_and_Punctuation
+}
+
+// https://en.wikipedia.org/wiki/Halfwidth_and_Fullwidth_Forms_(Unicode_block)
+var fullwidthLatinRange = [][]rune{
 	{
-		'\u3000', '\u303F',
+		'\uFF21', '\uFF3A', // Fullwidth Latin capital letters
 	},
-	// https://en.wikipedia.org/wiki/Chinese_punctuation
 	{
-		'\uFF0C',
-		'\uFF0C',
+		'\uFF41', '\uFF5A', // Fullwidth Latin small letters
 	},
+}
+
+// https://en.wikipedia.org/wiki/Bopomofo
+var bopomofoRange = [][]rune{
 	{
-		'\uFF01',
-		'\uFF01',
+		'\u3100', '\u312F', // Bopomofo
 	},
 	{
-		'\uFF1F',
-		'\uFF1F',
+		'\u31A0', '\u31BF', // Bopomofo Extended
 	},
 	{
-		'\uFF1A',
-		'\uFF1B',
+		'\u02C7', '\u02C7', // tone mark ˇ
 	},
 	{
-		'\uFF08',
-		'\uFF09',
+		'\u02CA', '\u02CB', // tone marks ˊ ˋ
 	},
 	{
-		'\uFF3B',
-		'\uFF3B',
+		'\u02D9', '\u02D9', // tone mark ˙
 	},
+}
+
+// https://en.wikipedia.org/wiki/Kangxi_radical
+var radicalRange = [][]rune{
 	{
-		'\uFF3D',
-		'\uFF3D',
+		'\u2E80', '\u2EF3', // CJK Radicals Supplement
 	},
 	{
-		'\u3010',
-		'\u3011',
+		'\u2F00', '\u2FD5', // Kangxi Radicals
 	},
 }
 
-var simplifiedDict map[rune]rune
-var traditionalDict map[rune]rune
+var commonRange = concatRanges(ideographRange, symbolRange, punctuationRange)
 
-func init() {
-	simplifiedDict = make(map[rune]rune)
-	traditionalDict = make(map[rune]rune)
-	err := buildDictionary(simplifiedDict, traditionalDict)
-	if err != nil {
-		panic(err)
+// sorted and merged copies of the ranges above for inRanges
+var (
+	ideographTable      = mergeRanges(ideographRange)
+	punctuationTable    = mergeRanges(punctuationRange)
+	fullwidthLatinTable = mergeRanges(fullwidthLatinRange)
+	bopomofoTable       = mergeRanges(bopomofoRange)
+	radicalTable        = mergeRanges(radicalRange)
+	commonTable         = mergeRanges(commonRange)
+)
+
+func concatRanges(ranges ...[][]rune) [][]rune {
+	var res [][]rune
+	for _, r := range ranges {
+		res = append(res, r...)
 	}
+	return res
+}
+
+// dictionaries package variant dictionaries, replaced as a whole by LoadDictionaryFromFS
+type dictionaries struct {
+	simplifiedDict  runeMap
+	traditionalDict runeMap
+	// all traditional variants by simplified character and all simplified variants by traditional character
+	traditionalVariants map[rune][]rune
+	simplifiedVariants  map[rune][]rune
+	// file the dictionaries are built from, parsed again by related
+	fsys        fs.FS
+	name        string
+	relatedOnce sync.Once
+	// kSemanticVariant and kZVariant in both directions, see RelatedVariants
+	relatedVariants map[rune][]rune
+}
+
+// sharedChars Chinese unicode in use in both scripts, although Unihan only lists a traditional variant for them.
+// They are traditional characters which took over the meaning of another character on simplification, e.g. 了 of 瞭.
+// Characters in neither variant dictionary, e.g. 人 or 中, are shared without being listed here.
+var sharedChars = makeRuneSet("" +
+	"了干里谷丑斗才松范几准千划卜借克合吁咸姜朴折蒙制郁困" +
+	"仆卷回夸奸家御栗沈洒涂淀秋筑胡蔑冬丰佣并伙刮舍霉朱")
+
+func isSharedChar(r rune) bool {
+	_, ok := sharedChars[r]
+	return ok
+}
+
+func (d *dictionaries) empty() bool {
+	return len(d.traditionalVariants) == 0 && len(d.simplifiedVariants) == 0
+}
+
+// related return the related variants, parsed from the file of the dictionaries on first use,
+// so that they are only kept in memory for RelatedVariants and WithRelatedVariants. They are empty if the file fails to parse again.
+func (d *dictionaries) related() map[rune][]rune {
+	d.relatedOnce.Do(func() {
+		if d.fsys == nil {
+			return
+		}
+		file, err := d.fsys.Open(d.name)
+		if err != nil {
+			// eat err
+			return
+		}
+		defer file.Close()
+		related := make(map[rune][]rune)
+		if _, _, err := parseVariantLists(file, related); err != nil {
+			// eat err
+			return
+		}
+		d.relatedVariants = related
+	})
+	return d.relatedVariants
+}
+
+var dictOnce sync.Once
+
+// dictValue *dictionaries, nil until loadDictionaries
+var dictValue atomic.Value
+
+// dictFS file system the package dictionaries are built from, replaced by tests
+var dictFS fs.FS = embedded
+
+// dictErr error building the package dictionaries, set by loadDictionaries
+var dictErr error
+
+// ignoreDictionaryErrorEnv environment variable to continue with empty dictionaries instead of panicking, see DictionaryErr
+const ignoreDictionaryErrorEnv = "ISCHINESE_IGNORE_DICTIONARY_ERROR"
+
+// loadDictionaries return the package dictionaries, built from the embedded Unihan_Variants.txt on first use,
+// so that detection not depending on variants, e.g. IsChinese, never parses it. They are empty without the embedded file.
+func loadDictionaries() *dictionaries {
+	dictOnce.Do(func() {
+		if !hasEmbeddedDictionary {
+			dictValue.Store(&dictionaries{})
+			return
+		}
+		dicts, err := buildDictionary(dictFS, "Unihan_Variants.txt")
+		if err == nil && dicts.empty() {
+			err = fmt.Errorf("Unihan_Variants.txt: %w", errEmptyDictionary)
+		}
+		if err != nil {
+			if os.Getenv(ignoreDictionaryErrorEnv) != "1" {
+				panic(err)
+			}
+			log.Printf("ischinese: %v, continuing with empty variant dictionaries", err)
+			dictErr = err
+			dicts = &dictionaries{}
+		}
+		dictValue.Store(dicts)
+	})
+	return dictValue.Load().(*dictionaries)
+}
+
+// DictionaryErr return the error building the package dictionaries from the embedded Unihan_Variants.txt, building them if not yet.
+// The error panics unless the environment variable ISCHINESE_IGNORE_DICTIONARY_ERROR is 1, which logs it and leaves the dictionaries empty,
+// so that every Chinese unicode code point is both simplified and traditional, until LoadDictionaryFromFS succeeds.
+func DictionaryErr() error {
+	loadDictionaries()
+	return dictErr
 }
 
-//go:embed Unihan_Variants.txt
-var fs embed.FS
+var errEmptyDictionary = errors.New("no kSimplifiedVariant or kTraditionalVariant found")
 
-func buildDictionary(simplifiedDict, traditionalDict map[rune]rune) error {
-	file, err := fs.Open("Unihan_Variants.txt")
+// LoadDictionaryFromFS replace the package variant dictionaries with the ones parsed from file name of fsys in Unihan_Variants.txt format,
+// e.g. a newer Unihan release. The package dictionaries are left untouched if it fails to parse or has no variants.
+// Detectors created with WithVariantFile, AddVariant or LoadVariants keep the copy they made of the former ones.
+func LoadDictionaryFromFS(fsys fs.FS, name string) error {
+	dicts, err := buildDictionary(fsys, name)
 	if err != nil {
 		return err
 	}
+	if dicts.empty() {
+		return fmt.Errorf("%s: %w", name, errEmptyDictionary)
+	}
+	// a later first use must not overwrite dicts with the embedded ones
+	loadDictionaries()
+	dictValue.Store(dicts)
+	return nil
+}
+
+// buildDictionary parse file name of fsys in Unihan_Variants.txt format, leaving the related variants to related
+func buildDictionary(fsys fs.FS, name string) (*dictionaries, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
+	traditional, simplified, err := parseVariantLists(file, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &dictionaries{
+		simplifiedDict:      newRuneMap(firstVariants(traditional)),
+		traditionalDict:     newRuneMap(firstVariants(simplified)),
+		traditionalVariants: traditional,
+		simplifiedVariants:  simplified,
+		fsys:                fsys,
+		name:                name,
+	}, nil
+}
+
+// parseVariants parse kSimplifiedVariant and kTraditionalVariant lines in Unihan_Variants.txt format into the dictionaries,
+// keeping the first variant of parseVariantLists
+func parseVariants(r io.Reader, simplifiedDict, traditionalDict map[rune]rune) error {
+	traditionalVariants, simplifiedVariants, err := parseVariantLists(r, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range firstVariants(traditionalVariants) {
+		simplifiedDict[k] = v
+	}
+	for k, v := range firstVariants(simplifiedVariants) {
+		traditionalDict[k] = v
+	}
+	return nil
+}
+
+// firstVariants map each character to its first variant
+func firstVariants(variants map[rune][]rune) map[rune]rune {
+	dict := make(map[rune]rune, len(variants))
+	for k, v := range variants {
+		dict[k] = v[0]
+	}
+	return dict
+}
 
-	parseUnicode := func(k, v string, dict map[rune]rune) {
+// parseVariantLists parse kSimplifiedVariant and kTraditionalVariant lines in Unihan_Variants.txt format
+// into all traditional variants by simplified character and all simplified variants by traditional character.
+// The variants in the line of the character itself come first in their order, the ones from lines of other characters follow,
+// e.g. 发 has 發 and 髮 from "U+53D1 kTraditionalVariant U+767C U+9AEE".
+// Unless related is nil, kSemanticVariant and kZVariant lines are parsed into it the same way, without their source tags,
+// e.g. 乾 has 乹 and 亁 from "U+4E7E kSemanticVariant U+4E79<kMorohashi:T U+4E81<kMorohashi:T".
+func parseVariantLists(r io.Reader, related map[rune][]rune) (traditionalVariants, simplifiedVariants map[rune][]rune, err error) {
+	// variants from the line of the character itself and from lines of other characters
+	traditionalOwn, traditionalOther := make(map[rune][]rune), make(map[rune][]rune)
+	simplifiedOwn, simplifiedOther := make(map[rune][]rune), make(map[rune][]rune)
+	relatedOwn, relatedOther := make(map[rune][]rune), make(map[rune][]rune)
+	add := func(dict map[rune][]rune, k, v string) {
 		kR, err := parseUnicodeString(k)
 		if err != nil {
 			// eat err
@@ -149,10 +392,10 @@ func buildDictionary(simplifiedDict, traditionalDict map[rune]rune) error {
 			// eat err
 			return
 		}
-		dict[kR] = vR
+		dict[kR] = append(dict[kR], vR)
 	}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
 		// skip comments
@@ -166,19 +409,61 @@ func buildDictionary(simplifiedDict, traditionalDict map[rune]rune) error {
 		switch fields[1] {
 		case "kSimplifiedVariant":
 			for _, field := range fields[2:] {
-				parseUnicode(field, fields[0], simplifiedDict)
-				parseUnicode(fields[0], field, traditionalDict)
+				add(traditionalOther, field, fields[0])
+				add(simplifiedOwn, fields[0], field)
 			}
 		case "kTraditionalVariant":
 			for _, field := range fields[2:] {
-				parseUnicode(field, fields[0], traditionalDict)
-				parseUnicode(fields[0], field, simplifiedDict)
+				add(simplifiedOther, field, fields[0])
+				add(traditionalOwn, fields[0], field)
+			}
+		case "kSemanticVariant", "kZVariant":
+			if related == nil {
+				continue
+			}
+			for _, field := range fields[2:] {
+				// drop the source tags, e.g. <kMatthews
+				field = strings.SplitN(field, "<", 2)[0]
+				if field != fields[0] {
+					add(relatedOther, field, fields[0])
+					add(relatedOwn, fields[0], field)
+				}
 			}
 		default:
 			continue
 		}
 	}
-	return scanner.Err()
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	for k, v := range mergeVariants(relatedOwn, relatedOther) {
+		related[k] = v
+	}
+	return mergeVariants(traditionalOwn, traditionalOther), mergeVariants(simplifiedOwn, simplifiedOther), nil
+}
+
+// mergeVariants append the variants of other to the ones of own, skipping duplicates
+func mergeVariants(own, other map[rune][]rune) map[rune][]rune {
+	merged := make(map[rune][]rune, len(own))
+	for _, dict := range []map[rune][]rune{own, other} {
+		for k, variants := range dict {
+			for _, v := range variants {
+				if !containsRune(merged[k], v) {
+					merged[k] = append(merged[k], v)
+				}
+			}
+		}
+	}
+	return merged
+}
+
+func containsRune(runes []rune, r rune) bool {
+	for _, c := range runes {
+		if c == r {
+			return true
+		}
+	}
+	return false
 }
 
 const unicodeStringPrefix = "U+"
@@ -198,91 +483,297 @@ func parseUnicodeString(s string) (rune, error) {
 	if err != nil {
 		return 0, err
 	}
-	return rune(binary.BigEndian.Uint32(bs)), nil
+	// surrogates and code points beyond U+10FFFF, which overflow rune from U+80000000 on
+	r := rune(binary.BigEndian.Uint32(bs))
+	if times == 8 || !utf8.ValidRune(r) {
+		return 0, errors.New("invalid unicode")
+	}
+	return r, nil
 }
 
-func isChineseChar(r rune) bool {
-	for _, runes := range commonRange {
-		if runes[0] <= r && r <= runes[1] {
+// inRanges binary search r in ranges, which must be sorted and disjoint, see mergeRanges
+func inRanges(r rune, ranges [][]rune) bool {
+	lo, hi := 0, len(ranges)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case r < ranges[m][0]:
+			hi = m
+		case r > ranges[m][1]:
+			lo = m + 1
+		default:
 			return true
 		}
 	}
 	return false
 }
 
+//go:generate go test -run TestRangeSwitch -update
+
+// isChineseChar Chinese unicode for Default, whose ranges test is isChineseCharSwitch generated from commonTable,
+// which beats inRanges on both Chinese and non Chinese text
+func isChineseChar(r rune) bool {
+	return Default().isChineseChar(r)
+}
+
+func isIdeographChar(r rune) bool {
+	return inRanges(r, ideographTable)
+}
+
+func isFullwidthLatinChar(r rune) bool {
+	return inRanges(r, fullwidthLatinTable)
+}
+
+// isRadicalChar true for Kangxi Radicals and CJK Radicals Supplement, which are not Chinese unicode by default, see WithRadicals
+func isRadicalChar(r rune) bool {
+	return inRanges(r, radicalTable)
+}
+
+// isBopomofoChar true for Bopomofo (Zhuyin), including the tone marks, which are not Chinese unicode
+func isBopomofoChar(r rune) bool {
+	return inRanges(r, bopomofoTable)
+}
+
+func isPunctuationChar(r rune) bool {
+	return inRanges(r, punctuationTable)
+}
+
+// isSimplifiedChineseChar simplified Chinese unicode for Default, which needs no read lock as it never changes
 func isSimplifiedChineseChar(r rune) bool {
-	if !isChineseChar(r) {
-		return false
+	return Default().isSimplifiedChineseChar(r)
+}
+
+// isTraditionalChineseChar traditional Chinese unicode for Default, which needs no read lock as it never changes
+func isTraditionalChineseChar(r rune) bool {
+	return Default().isTraditionalChineseChar(r)
+}
+
+func isSimplifiedVariant(r rune) bool {
+	dicts := loadDictionaries()
+	return isSimplifiedVariantIn(r, dicts.simplifiedDict, dicts.traditionalDict)
+}
+
+func isTraditionalVariant(r rune) bool {
+	dicts := loadDictionaries()
+	return isTraditionalVariantIn(r, dicts.simplifiedDict, dicts.traditionalDict)
+}
+
+// isSimplifiedVariantIn true unless unicode code point is known as traditional only
+func isSimplifiedVariantIn(r rune, simplifiedDict, traditionalDict runeSet) bool {
+	if isSharedChar(r) {
+		return true
 	}
-	if _, ok := simplifiedDict[r]; ok {
+	if simplifiedDict.contains(r) {
 		return true
 	}
-	if _, ok := traditionalDict[r]; ok {
+	if traditionalDict.contains(r) {
 		return false
 	}
 	return true
 }
 
-func isTraditionalChineseChar(r rune) bool {
-	if !isChineseChar(r) {
-		return false
+// isTraditionalVariantIn true unless unicode code point is known as simplified only
+func isTraditionalVariantIn(r rune, simplifiedDict, traditionalDict runeSet) bool {
+	if isSharedChar(r) {
+		return true
 	}
-	if _, ok := traditionalDict[r]; ok {
+	if traditionalDict.contains(r) {
 		return true
 	}
-	if _, ok := simplifiedDict[r]; ok {
+	if simplifiedDict.contains(r) {
 		return false
 	}
 	return true
 }
 
+// IsChineseRune true if unicode code point is Chinese unicode
+func IsChineseRune(r rune) bool {
+	return isChineseChar(r)
+}
+
+// IsSimplifiedChineseRune true if unicode code point is simplified Chinese unicode
+func IsSimplifiedChineseRune(r rune) bool {
+	return isSimplifiedChineseChar(r)
+}
+
+// IsTraditionalChineseRune true if unicode code point is traditional Chinese unicode
+func IsTraditionalChineseRune(r rune) bool {
+	return isTraditionalChineseChar(r)
+}
+
+// IsCJKPunctuation true if unicode code point is Chinese punctuation, e.g. ，or 。 or 【, which are Chinese unicode but not ideographs
+func IsCJKPunctuation(r rune) bool {
+	return isPunctuationChar(r)
+}
+
 // IsChinese true if more than 50% of unicode code points are Chinese unicode
 func IsChinese(s string) bool {
-	return nonPureFuncHelper(s, isChineseChar)
+	return Default().IsChinese(s)
+}
+
+var radicalDetector = NewDetector(WithRadicals(true))
+
+// IsChineseIncludingRadicals same as IsChinese, but Kangxi Radicals (U+2F00–U+2FD5) and CJK Radicals Supplement (U+2E80–U+2EF3),
+// e.g. ⼀ or ⺁, are Chinese unicode too, see WithRadicals
+func IsChineseIncludingRadicals(s string) bool {
+	return radicalDetector.IsChinese(s)
+}
+
+// IsBopomofo true if more than 50% of unicode code points are Bopomofo (Zhuyin), e.g. ㄅㄆㄇㄈ or ㄓㄨˋ ㄧㄣ
+func IsBopomofo(s string) bool {
+	return IsMostly(s, isBopomofoChar, 0.5)
+}
+
+// IsChineseIgnorePunctuation true if more than 50% of unicode code points other than Chinese punctuation are Chinese unicode,
+// see IsCJKPunctuation. Chinese punctuation only is true like empty string.
+func IsChineseIgnorePunctuation(s string) bool {
+	return Default().IsChineseIgnorePunctuation(s)
+}
+
+// IsChineseIdeographsOnly true if more than 50% of unicode code points other than skippable ones are CJK ideographs.
+// Skippable are whitespace, punctuation including Chinese punctuation, and symbols (unicode categories Z, P, S and
+// Chinese punctuation, see IsCJKPunctuation), they count toward neither Chinese nor total. Skippable only is true like empty string.
+func IsChineseIdeographsOnly(s string) bool {
+	return Default().IsChineseIdeographsOnly(s)
 }
 
 // IsSimplifiedChinese true if more than 50% of unicode code points are simplified Chinese unicode
 func IsSimplifiedChinese(s string) bool {
-	return nonPureFuncHelper(s, isSimplifiedChineseChar)
+	return Default().IsSimplifiedChinese(s)
 }
 
 // IsTraditionalChinese true if more than 50% of unicode code points are traditional Chinese unicode
 func IsTraditionalChinese(s string) bool {
-	return nonPureFuncHelper(s, isTraditionalChineseChar)
+	return Default().IsTraditionalChinese(s)
 }
-func nonPureFuncHelper(s string, f func(rune) bool) bool {
-	if len(s) == 0 {
-		return true
-	}
-	var counter float32
-	var total float32
+
+// IsMostly true if more than threshold of unicode code points satisfy f, exactly threshold is false, empty string is true.
+// It is the building block of IsChinese and the other threshold functions, f may combine their predicates,
+// e.g. IsSimplifiedChineseRune(r) || unicode.IsPunct(r).
+func IsMostly(s string, f func(rune) bool, threshold float64) bool {
+	c := countString(s, f)
+	return c.mostly(threshold)
+}
+
+// ratioHelper return the ratio of unicode code points satisfying f, 0 for empty string
+func ratioHelper(s string, f func(rune) bool) float64 {
+	c := countString(s, f)
+	return c.ratio()
+}
+
+// countHelper return the number of unicode code points satisfying f
+func countHelper(s string, f func(rune) bool) int {
+	return countString(s, f).counter
+}
+
+func countString(s string, f func(rune) bool) runeCounter {
+	c := runeCounter{f: f}
 	for _, r := range s {
-		total++
-		if !f(r) {
-			debug(string([]rune{r}))
-		} else {
-			counter++
-		}
+		c.add(r)
+	}
+	return c
+}
+
+// runeCounter count unicode code points and the ones satisfying f, shared by the string, []byte and io.Reader helpers
+type runeCounter struct {
+	f       func(rune) bool
+	counter int
+	total   int
+}
+
+func (c *runeCounter) add(r rune) {
+	c.total++
+	if !c.f(r) {
+		debug(string([]rune{r}))
+	} else {
+		c.counter++
+	}
+}
+
+// ratio return counter / total, 0 if nothing was counted
+func (c *runeCounter) ratio() float64 {
+	if c.total == 0 {
+		return 0
 	}
-	return counter/total > 0.5
+	return float64(c.counter) / float64(c.total)
+}
+
+// mostly true if ratio is more than threshold, true if nothing was counted
+func (c *runeCounter) mostly(threshold float64) bool {
+	if c.total == 0 {
+		return true
+	}
+	return c.ratio() > threshold
+}
+
+// ChineseRatio return the ratio of unicode code points which are Chinese unicode, 0 for empty string
+func ChineseRatio(s string) float64 {
+	return Default().ChineseRatio(s)
+}
+
+// SimplifiedChineseRatio return the ratio of unicode code points which are simplified Chinese unicode, 0 for empty string
+func SimplifiedChineseRatio(s string) float64 {
+	return Default().SimplifiedChineseRatio(s)
+}
+
+// TraditionalChineseRatio return the ratio of unicode code points which are traditional Chinese unicode, 0 for empty string
+func TraditionalChineseRatio(s string) float64 {
+	return Default().TraditionalChineseRatio(s)
+}
+
+// CountChinese return the number of unicode code points which are Chinese unicode
+func CountChinese(s string) int {
+	return Default().CountChinese(s)
+}
+
+// CountSimplifiedChinese return the number of unicode code points which are simplified Chinese unicode
+func CountSimplifiedChinese(s string) int {
+	return Default().CountSimplifiedChinese(s)
+}
+
+// CountTraditionalChinese return the number of unicode code points which are traditional Chinese unicode
+func CountTraditionalChinese(s string) int {
+	return Default().CountTraditionalChinese(s)
+}
+
+// IsChineseWithThreshold true if more than ratio of unicode code points are Chinese unicode, exactly ratio is false
+func IsChineseWithThreshold(s string, ratio float64) bool {
+	return Default().IsChineseWithThreshold(s, ratio)
+}
+
+// IsSimplifiedChineseWithThreshold true if more than ratio of unicode code points are simplified Chinese unicode, exactly ratio is false
+func IsSimplifiedChineseWithThreshold(s string, ratio float64) bool {
+	return Default().IsSimplifiedChineseWithThreshold(s, ratio)
+}
+
+// IsTraditionalChineseWithThreshold true if more than ratio of unicode code points are traditional Chinese unicode, exactly ratio is false
+func IsTraditionalChineseWithThreshold(s string, ratio float64) bool {
+	return Default().IsTraditionalChineseWithThreshold(s, ratio)
 }
 
 // IsPureChinese true if 100% of unicode code points are Chinese unicode
 func IsPureChinese(s string) bool {
-	return pureFuncHelper(s, isChineseChar)
+	return Default().IsPureChinese(s)
+}
+
+// IsNearlyPureChinese true if at most maxNonChinese unicode code points are not Chinese unicode, e.g. a stray space,
+// IsPureChinese for 0. Empty string is true.
+func IsNearlyPureChinese(s string, maxNonChinese int) bool {
+	return Default().IsNearlyPureChinese(s, maxNonChinese)
 }
 
 // IsPureSimplifiedChinese true if 100% of unicode code points are simplified Chinese unicode
 func IsPureSimplifiedChinese(s string) bool {
-	return pureFuncHelper(s, isSimplifiedChineseChar)
+	return Default().IsPureSimplifiedChinese(s)
 }
 
 // IsPureTraditionalChinese true if 100% of unicode code points are traditional Chinese unicode
 func IsPureTraditionalChinese(s string) bool {
-	return pureFuncHelper(s, isTraditionalChineseChar)
+	return Default().IsPureTraditionalChinese(s)
 }
 
-func pureFuncHelper(s string, f func(rune) bool) bool {
+// IsAll true if every unicode code point satisfies f, empty string is true. It is the building block of IsPureChinese and the other pure functions.
+func IsAll(s string, f func(rune) bool) bool {
 	for _, r := range s {
 		if !f(r) {
 			debug(string([]rune{r}))
@@ -292,18 +783,137 @@ func pureFuncHelper(s string, f func(rune) bool) bool {
 	return true
 }
 
-// Convert2Simplified replace traditional unicode code point with simplified one
-func Convert2Simplified(s string) string {
-	var res []rune
-	for _, r := range s {
-		res = append(res, replace2SimplifiedChar(r))
+// FirstNonChinese return the first unicode code point which is not Chinese unicode and its byte offset in s,
+// false with offset -1 if every unicode code point is Chinese unicode, see IsPureChinese
+func FirstNonChinese(s string) (rune, int, bool) {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !isChineseChar(r)
+	})
+	if i < 0 {
+		return 0, -1, false
+	}
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return r, i, true
+}
+
+var errInvalidUTF8 = errors.New("invalid UTF-8")
+
+// IsPureChineseStrict same as IsPureChinese, but return an error at the first invalid UTF-8 byte, including encoded surrogates.
+// The other functions are lenient and count each invalid byte as a non Chinese unicode code point (utf8.RuneError).
+func IsPureChineseStrict(s string) (bool, error) {
+	for i, r := range s {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				return false, fmt.Errorf("%w at byte offset %d", errInvalidUTF8, i)
+			}
+		}
+	}
+	return IsPureChinese(s), nil
+}
+
+// NonChineseError non Chinese unicode code point found by ValidatePureChinese
+type NonChineseError struct {
+	Rune rune
+	// Offset byte offset in the validated string
+	Offset int
+}
+
+func (e *NonChineseError) Error() string {
+	return fmt.Sprintf("non Chinese unicode %q (%U) at byte offset %d", e.Rune, e.Rune, e.Offset)
+}
+
+// ValidatePureChinese return a *NonChineseError for the first unicode code point which is not Chinese unicode,
+// nil if IsPureChinese, see FirstNonChinese
+func ValidatePureChinese(s string) error {
+	if r, offset, ok := FirstNonChinese(s); ok {
+		return &NonChineseError{Rune: r, Offset: offset}
 	}
-	return string(res)
+	return nil
+}
+
+// Convert2Simplified replace traditional unicode code point with simplified one, same as ToSimplified
+func Convert2Simplified(s string) string {
+	return ToSimplified(s)
+}
+
+// ToSimplified replace traditional unicode code point with simplified one, leaving others untouched.
+// A traditional character with several simplified variants is replaced with the first one Unihan lists for it.
+func ToSimplified(s string) string {
+	return Default().ToSimplified(s)
 }
 
 func replace2SimplifiedChar(r rune) rune {
-	if replaced, ok := traditionalDict[r]; ok {
-		return replaced
+	return Default().replace2SimplifiedChar(r)
+}
+
+// ToTraditional replace simplified unicode code point with traditional one.
+// A simplified character with several traditional variants is replaced with the first one Unihan lists for it, e.g. 发 with 發, not 髮.
+func ToTraditional(s string) string {
+	return Default().ToTraditional(s)
+}
+
+func replace2TraditionalChar(r rune) rune {
+	return Default().replace2TraditionalChar(r)
+}
+
+// TraditionalVariants return all traditional variants Unihan lists for simplified character r, the one ToTraditional picks first,
+// nil if r has none. Detection does not pick, any character with a traditional variant is simplified unicode.
+func TraditionalVariants(r rune) []rune {
+	return append([]rune(nil), loadDictionaries().traditionalVariants[r]...)
+}
+
+// SimplifiedVariants return all simplified variants Unihan lists for traditional character r, the one ToSimplified picks first,
+// nil if r has none. Detection does not pick, any character with a simplified variant is traditional unicode.
+func SimplifiedVariants(r rune) []rune {
+	return append([]rune(nil), loadDictionaries().simplifiedVariants[r]...)
+}
+
+// RelatedVariants return the semantic variants (kSemanticVariant) and z-variants (kZVariant, same character in a different glyph)
+// Unihan lists for r in either direction, e.g. 乹 and 亁 for 乾, nil if r has none. They are for broad equivalence, e.g. search,
+// and never affect simplified and traditional detection unless WithRelatedVariants. The first call parses Unihan_Variants.txt again.
+func RelatedVariants(r rune) []rune {
+	return append([]rune(nil), loadDictionaries().related()[r]...)
+}
+
+// IsScriptInvariant true if unicode code point is left unchanged when converted to either simplified or traditional
+func IsScriptInvariant(r rune) bool {
+	return replace2SimplifiedChar(r) == r && replace2TraditionalChar(r) == r
+}
+
+// ContainsFunc true if any unicode code point satisfies f, stopping at the first one, false for empty string.
+// It is the building block of ContainsChinese and the other contains functions, e.g. ContainsFunc(s, IsCJKPunctuation).
+func ContainsFunc(s string, f func(rune) bool) bool {
+	for _, r := range s {
+		if f(r) {
+			return true
+		}
 	}
-	return r
+	return false
+}
+
+// ContainsChinese true if any unicode code point is Chinese unicode, false for empty string
+func ContainsChinese(s string) bool {
+	return Default().ContainsChinese(s)
+}
+
+// ContainsSimplifiedChinese true if any unicode code point is simplified Chinese unicode, including characters valid in both scripts
+func ContainsSimplifiedChinese(s string) bool {
+	return Default().ContainsSimplifiedChinese(s)
+}
+
+// ContainsTraditionalChinese true if any unicode code point is traditional Chinese unicode, including characters valid in both scripts
+func ContainsTraditionalChinese(s string) bool {
+	return Default().ContainsTraditionalChinese(s)
+}
+
+// ContainsFullwidthLatin true if any unicode code point is a fullwidth Latin letter (Ａ-Ｚ, ａ-ｚ)
+func ContainsFullwidthLatin(s string) bool {
+	return ContainsFunc(s, isFullwidthLatinChar)
+}
+
+// RendersSameAcrossScripts true if 100% of unicode code points are script invariant,
+// i.e. converting s to the other script produces the same code points.
+// It is stricter than a round trip check: 國 converts to 国 and back to 國, but does not render the same.
+func RendersSameAcrossScripts(s string) bool {
+	return IsAll(s, IsScriptInvariant)
 }
@@ -1,9 +1,28 @@
 package ischinese
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"unicode"
+	"unicode/utf8"
 )
 
+// requireEmbeddedDictionary skip tests depending on the package dictionaries, which are empty with the build tag ischinese_noembed
+func requireEmbeddedDictionary(t *testing.T) {
+	t.Helper()
+	if !hasEmbeddedDictionary {
+		t.Skip("the package dictionaries are empty with the build tag ischinese_noembed")
+	}
+}
+
 func TestParseUnicodeString(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -31,6 +50,22 @@ func TestParseUnicodeString(t *testing.T) {
 			s:       "G",
 			wantErr: true,
 		},
+		{
+			s:       "U+",
+			wantErr: true,
+		},
+		{
+			s:       "U+D800",
+			wantErr: true,
+		},
+		{
+			s:       "U+110000",
+			wantErr: true,
+		},
+		{
+			s:       "U+FFFFFFFF",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -47,20 +82,60 @@ func TestParseUnicodeString(t *testing.T) {
 }
 
 func Test_buildDictionary(t *testing.T) {
-	simplifiedDict := make(map[rune]rune)
-	traditionalDict := make(map[rune]rune)
-	err := buildDictionary(simplifiedDict, traditionalDict)
+	requireEmbeddedDictionary(t)
+	_, err := buildDictionary(embedded, "Unihan_Variants.txt")
 	if err != nil {
 		t.Error(err)
 	}
 }
 
+// BenchmarkBuildDictionary the startup cost loadDictionaries defers until variants are needed
+func BenchmarkBuildDictionary(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := buildDictionary(embedded, "Unihan_Variants.txt"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestLazyDictionaries run in a new process, the dictionaries of this one are loaded by other tests
+func TestLazyDictionaries(t *testing.T) {
+	if os.Getenv("ISCHINESE_LAZY_DICTIONARIES") == "1" {
+		IsChinese("中文")
+		IsPureChinese("中文")
+		CountChinese("中文")
+		if dictValue.Load() != nil {
+			t.Fatal("dictionaries loaded by IsChinese, IsPureChinese or CountChinese")
+		}
+		IsSimplifiedChinese("中文")
+		if dictValue.Load() == nil {
+			t.Error("dictionaries not loaded by IsSimplifiedChinese")
+		}
+		return
+	}
+	// in a new process, as the dictionaries of this one may be loaded already
+	cmd := exec.Command(os.Args[0], "-test.run=^TestLazyDictionaries$")
+	cmd.Env = append(os.Environ(), "ISCHINESE_LAZY_DICTIONARIES=1")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("%v\n%s", err, out)
+	}
+}
+
 func TestIsPureSimplifiedChinese(t *testing.T) {
+	requireEmbeddedDictionary(t)
 	tests := []struct {
 		name string
 		s    string
 		want bool
 	}{
+		{
+			s:    "了人中",
+			want: true,
+		},
+		{
+			s:    "鐘",
+			want: false,
+		},
 		{
 			s:    "",
 			want: true,
@@ -109,15 +184,28 @@ func TestIsPureSimplifiedChinese(t *testing.T) {
 }
 
 func TestIsPureTraditionalChinese(t *testing.T) {
+	requireEmbeddedDictionary(t)
 	tests := []struct {
 		name string
 		s    string
 		want bool
 	}{
-		//{
-		//	s:    "了", // TODO: both in simplified and traditional in real world, but not in traditionalDict
-		//	want: true,
-		//},
+		{
+			s:    "了", // both in simplified and traditional in real world, but not in traditionalDict
+			want: true,
+		},
+		{
+			s:    "人",
+			want: true,
+		},
+		{
+			s:    "中",
+			want: true,
+		},
+		{
+			s:    "钟",
+			want: false,
+		},
 		{
 			s:    "",
 			want: true,
@@ -147,6 +235,7 @@ func TestIsPureTraditionalChinese(t *testing.T) {
 			want: false,
 		},
 		// source: https://zh.wikipedia.org/wiki/%E5%B0%84%E9%B5%B0%E8%8B%B1%E9%9B%84%E5%82%B3
+		// 鵰 is simplified to 𫛲 (U+2B6F2), not 雕
 		{
 			s:    "《射鵰英雄傳》小說前後一共有三個版本：連載版（舊版）、流行版（新版）、世紀修訂版（新修版）。",
 			want: true,
@@ -210,6 +299,7 @@ func TestIsSimplifiedChinese(t *testing.T) {
 }
 
 func TestConvert2Simplified(t *testing.T) {
+	requireEmbeddedDictionary(t)
 	tests := []struct {
 		name string
 		s    string
@@ -232,3 +322,1358 @@ func TestConvert2Simplified(t *testing.T) {
 		})
 	}
 }
+
+func TestRendersSameAcrossScripts(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: true,
+		},
+		{
+			s:    "hello world",
+			want: true,
+		},
+		{
+			s:    "人中大",
+			want: true,
+		},
+		{
+			s:    "中国",
+			want: false,
+		},
+		{
+			s:    "中國",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RendersSameAcrossScripts(tt.s); got != tt.want {
+				t.Errorf("RendersSameAcrossScripts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsFullwidthLatin(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "AB你好",
+			want: false,
+		},
+		{
+			s:    "１２３，你好",
+			want: false,
+		},
+		{
+			s:    "ＡＢ你好",
+			want: true,
+		},
+		{
+			s:    "你好ｚ",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsFullwidthLatin(tt.s); got != tt.want {
+				t.Errorf("ContainsFullwidthLatin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsChineseRune(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		r               rune
+		want            bool
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			r: 'a',
+		},
+		{
+			r: 'こ',
+		},
+		{
+			r:               '中',
+			want:            true,
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+		{
+			r:              '国',
+			want:           true,
+			wantSimplified: true,
+		},
+		{
+			r:               '國',
+			want:            true,
+			wantTraditional: true,
+		},
+		{
+			r:               '，',
+			want:            true,
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+		{
+			r:               '\U00020000',
+			want:            true,
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+		{
+			r:              '\U00028C50', // simplified form of U+28AD2
+			want:           true,
+			wantSimplified: true,
+		},
+		{
+			r:               '\U00028AD2',
+			want:            true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseRune(tt.r); got != tt.want {
+				t.Errorf("IsChineseRune(%U) = %v, want %v", tt.r, got, tt.want)
+			}
+			if got := IsSimplifiedChineseRune(tt.r); got != tt.wantSimplified {
+				t.Errorf("IsSimplifiedChineseRune(%U) = %v, want %v", tt.r, got, tt.wantSimplified)
+			}
+			if got := IsTraditionalChineseRune(tt.r); got != tt.wantTraditional {
+				t.Errorf("IsTraditionalChineseRune(%U) = %v, want %v", tt.r, got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+func TestIsChineseWithThreshold(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		ratio float64
+		want  bool
+	}{
+		{
+			s:     "",
+			ratio: 0.8,
+			want:  true,
+		},
+		{
+			s:     "你好ab",
+			ratio: 0.5,
+			want:  false,
+		},
+		{
+			s:     "你好ab",
+			ratio: 0.49,
+			want:  true,
+		},
+		{
+			s:     "机器学习a",
+			ratio: 0.8,
+			want:  false,
+		},
+		{
+			s:     "机器学习好a",
+			ratio: 0.8,
+			want:  true,
+		},
+		{
+			s:     "机器学习",
+			ratio: 1,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseWithThreshold(tt.s, tt.ratio); got != tt.want {
+				t.Errorf("IsChineseWithThreshold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSimplifiedChineseWithThreshold(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		ratio           float64
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			s:               "国国國a",
+			ratio:           0.5,
+			wantSimplified:  false,
+			wantTraditional: false,
+		},
+		{
+			s:               "国国國a",
+			ratio:           0.25,
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+		{
+			s:               "国国國a",
+			ratio:           0.2,
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSimplifiedChineseWithThreshold(tt.s, tt.ratio); got != tt.wantSimplified {
+				t.Errorf("IsSimplifiedChineseWithThreshold() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := IsTraditionalChineseWithThreshold(tt.s, tt.ratio); got != tt.wantTraditional {
+				t.Errorf("IsTraditionalChineseWithThreshold() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+func TestToTraditional(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "大刘说说",
+			want: "大劉說說",
+		},
+		{
+			s:    "头发发现",
+			want: "頭發發現",
+		},
+		{
+			s:    "hello 世界，ok",
+			want: "hello 世界，ok",
+		},
+		{
+			s:    "喜欢锻炼的人，身体应该比较好，天天锻炼的人（比如运动员），就不一定好，旅游也是如此。",
+			want: "喜歡鍛煉的人，身體應該比較好，天天鍛煉的人（比如運動員），就不一定好，旅游也是如此。", // 游 is its own first traditional variant
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToTraditional(tt.s)
+			if got != tt.want {
+				t.Errorf("ToTraditional() = %v, want %v", got, tt.want)
+			}
+			if back := Convert2Simplified(got); back != tt.s {
+				t.Errorf("Convert2Simplified(ToTraditional()) = %v, want %v", back, tt.s)
+			}
+		})
+	}
+}
+
+func TestToSimplified(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "hello world 2021",
+			want: "hello world 2021",
+		},
+		{
+			s:    "你很機車哎",
+			want: "你很机车哎",
+		},
+		{
+			s:    "颱風",
+			want: "台风",
+		},
+		// 鵰 is simplified to 𫛲 (U+2B6F2), not 雕
+		{
+			s:    "《射鵰英雄傳》小說前後一共有三個版本：連載版（舊版）、流行版（新版）、世紀修訂版（新修版）。",
+			want: "《射\U0002B6F2英雄传》小说前后一共有三个版本：连载版（旧版）、流行版（新版）、世纪修订版（新修版）。",
+		},
+		{
+			s:    "然而連載《射鵰英雄傳》期間，因為金庸在長城電影公司擔任編劇和導演，瑣事繁多，精力時有不殆，所以小說中有很多情節他本人並不是非常滿意。",
+			want: "然而连载《射\U0002B6F2英雄传》期间，因为金庸在长城电影公司担任编剧和导演，琐事繁多，精力时有不殆，所以小说中有很多情节他本人并不是非常满意。",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToSimplified(tt.s); got != tt.want {
+				t.Errorf("ToSimplified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountChinese(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		want            int
+		wantSimplified  int
+		wantTraditional int
+	}{
+		{
+			s:               "",
+			want:            0,
+			wantSimplified:  0,
+			wantTraditional: 0,
+		},
+		{
+			s:               "abc",
+			want:            0,
+			wantSimplified:  0,
+			wantTraditional: 0,
+		},
+		{
+			s:               "ひらがなカタカナ",
+			want:            0,
+			wantSimplified:  0,
+			wantTraditional: 0,
+		},
+		{
+			s:               "中文abc国國です",
+			want:            4,
+			wantSimplified:  3,
+			wantTraditional: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountChinese(tt.s); got != tt.want {
+				t.Errorf("CountChinese() = %v, want %v", got, tt.want)
+			}
+			if got := CountSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("CountSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := CountTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("CountTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+// inRangesLinear the linear scan inRanges replaced, it accepts unsorted ranges
+func inRangesLinear(r rune, ranges [][]rune) bool {
+	for _, runes := range ranges {
+		if runes[0] <= r && r <= runes[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRangeTables(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges [][]rune
+		table  [][]rune
+	}{
+		{
+			name:   "ideograph",
+			ranges: ideographRange,
+			table:  ideographTable,
+		},
+		{
+			name:   "punctuation",
+			ranges: punctuationRange,
+			table:  punctuationTable,
+		},
+		{
+			name:   "fullwidth latin",
+			ranges: fullwidthLatinRange,
+			table:  fullwidthLatinTable,
+		},
+		{
+			name:   "bopomofo",
+			ranges: bopomofoRange,
+			table:  bopomofoTable,
+		},
+		{
+			name:   "radical",
+			ranges: radicalRange,
+			table:  radicalTable,
+		},
+		{
+			name:   "common",
+			ranges: commonRange,
+			table:  commonTable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for r := rune(0); r <= unicode.MaxRune; r++ {
+				if got, want := inRanges(r, tt.table), inRangesLinear(r, tt.ranges); got != want {
+					t.Fatalf("inRanges(%U) = %v, want %v", r, got, want)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkIsChinese the linear scan returns early for the CJK Unified Ideographs block listed first,
+// the binary search wins on runes outside the ranges, which the linear scan compares with every range,
+// the generated if-ladder bisects like the binary search without indexing the table and wins on both
+func BenchmarkIsChinese(b *testing.B) {
+	texts := []struct {
+		name string
+		s    string
+	}{
+		{
+			name: "chinese",
+			s:    strings.Repeat("在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户，但他却很尊敬朱元璋，在军营里，人们可以看到一个奇特的现象。", 100),
+		},
+		{
+			name: "english",
+			s:    strings.Repeat("The quick brown fox jumps over the lazy dog. ", 100),
+		},
+	}
+	for _, text := range texts {
+		b.Run(text.name+"/linear", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ratioHelper(text.s, func(r rune) bool {
+					return inRangesLinear(r, commonRange)
+				})
+			}
+		})
+		b.Run(text.name+"/binary", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ratioHelper(text.s, func(r rune) bool {
+					return inRanges(r, commonTable)
+				})
+			}
+		})
+		b.Run(text.name+"/switch", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ratioHelper(text.s, isChineseCharSwitch)
+			}
+		})
+	}
+}
+
+func TestSetDebugLogger(t *testing.T) {
+	var buf bytes.Buffer
+	SetDebugLogger(log.New(&buf, "", 0))
+	defer SetDebugLogger(nil)
+	tests := []struct {
+		name  string
+		debug bool
+		s     string
+		want  string
+	}{
+		{
+			debug: false,
+			s:     "中a文",
+			want:  "",
+		},
+		{
+			debug: true,
+			s:     "中a文b",
+			want:  "a\nb\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf.Reset()
+			SetDebug(tt.debug)
+			defer SetDebug(false)
+			IsChinese(tt.s)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("debug output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRangesDisjoint ideographs, symbols and punctuation do not overlap each other
+func TestRangesDisjoint(t *testing.T) {
+	ranges := map[string][][]rune{
+		"ideograph":   ideographRange,
+		"symbol":      symbolRange,
+		"punctuation": punctuationRange,
+	}
+	for name, a := range ranges {
+		for otherName, b := range ranges {
+			if name == otherName {
+				continue
+			}
+			for _, ra := range a {
+				for _, rb := range b {
+					if ra[0] <= rb[1] && rb[0] <= ra[1] {
+						t.Errorf("%s range %U overlaps %s range %U", name, ra, otherName, rb)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestIsCJKPunctuation(t *testing.T) {
+	tests := []struct {
+		name string
+		r    rune
+		want bool
+	}{
+		{
+			r:    '中',
+			want: false,
+		},
+		{
+			r:    ',',
+			want: false,
+		},
+		{
+			r:    '，',
+			want: true,
+		},
+		{
+			r:    '。',
+			want: true,
+		},
+		{
+			r:    '【',
+			want: true,
+		},
+		// vertical comma
+		{
+			r:    '\uFE10',
+			want: true,
+		},
+		// vertical left white lenticular bracket
+		{
+			r:    '\uFE17',
+			want: true,
+		},
+		// unassigned
+		{
+			r:    '\uFE1A',
+			want: false,
+		},
+		// presentation form for vertical left corner bracket
+		{
+			r:    '\uFE41',
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCJKPunctuation(tt.r); got != tt.want {
+				t.Errorf("IsCJKPunctuation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsChineseIgnorePunctuation(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: true,
+		},
+		{
+			s:    "，。！",
+			want: true,
+		},
+		{
+			s:    "中ab，。！",
+			want: false,
+		},
+		{
+			s:    "中文a，",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseIgnorePunctuation(tt.s); got != tt.want {
+				t.Errorf("IsChineseIgnorePunctuation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !IsChinese("中ab，。！") {
+		t.Errorf("IsChinese() = %v, want %v", false, true)
+	}
+}
+
+func TestIsBopomofo(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "ㄅㄆㄇㄈ",
+			want: true,
+		},
+		{
+			s:    "ㄓㄨˋ ㄧㄣ",
+			want: true,
+		},
+		{
+			s:    "ㆠㆡㆢ",
+			want: true,
+		},
+		{
+			s:    "注音ㄓㄨ",
+			want: false,
+		},
+		{
+			s:    "hello",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBopomofo(tt.s); got != tt.want {
+				t.Errorf("IsBopomofo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if IsChinese("ㄅㄆㄇㄈ") {
+		t.Errorf("IsChinese() = %v, want %v", true, false)
+	}
+}
+
+func TestContainsChinese(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		want            bool
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello world",
+		},
+		{
+			s: "こんにちは",
+		},
+		{
+			s:               "the word 人 means person",
+			want:            true,
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+		{
+			s:              "the word 国 means country",
+			want:           true,
+			wantSimplified: true,
+		},
+		{
+			s:               "the word 國 means country",
+			want:            true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsChinese(tt.s); got != tt.want {
+				t.Errorf("ContainsChinese() = %v, want %v", got, tt.want)
+			}
+			if got := ContainsSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("ContainsSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := ContainsTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("ContainsTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	var calls int
+	countingDigit := func(r rune) bool {
+		calls++
+		return unicode.IsDigit(r)
+	}
+	tests := []struct {
+		name string
+		s    string
+		f    func(rune) bool
+		want bool
+	}{
+		{
+			s:    "",
+			f:    IsCJKPunctuation,
+			want: false,
+		},
+		{
+			s:    "你好，世界",
+			f:    IsCJKPunctuation,
+			want: true,
+		},
+		{
+			s:    "你好, 世界",
+			f:    IsCJKPunctuation,
+			want: false,
+		},
+		{
+			s:    "注音ㄓㄨ",
+			f:    isBopomofoChar,
+			want: true,
+		},
+		{
+			s:    "第1章",
+			f:    unicode.IsDigit,
+			want: true,
+		},
+		// stops at the first match
+		{
+			name: "early exit",
+			s:    "12345",
+			f:    countingDigit,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsFunc(tt.s, tt.f); got != tt.want {
+				t.Errorf("ContainsFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if calls != 1 {
+		t.Errorf("ContainsFunc() called f %v times, want %v", calls, 1)
+	}
+}
+
+func TestFirstNonChinese(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		wantRune   rune
+		wantOffset int
+		wantFound  bool
+	}{
+		{
+			s:          "",
+			wantOffset: -1,
+		},
+		{
+			s:          "中文，测试。",
+			wantOffset: -1,
+		},
+		{
+			s:          "a中文",
+			wantRune:   'a',
+			wantOffset: 0,
+			wantFound:  true,
+		},
+		{
+			s:          "中文abc",
+			wantRune:   'a',
+			wantOffset: 6,
+			wantFound:  true,
+		},
+		{
+			s:          "中文こ",
+			wantRune:   'こ',
+			wantOffset: 6,
+			wantFound:  true,
+		},
+		{
+			s:          "中\xff",
+			wantRune:   utf8.RuneError,
+			wantOffset: 3,
+			wantFound:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, offset, found := FirstNonChinese(tt.s)
+			if r != tt.wantRune || offset != tt.wantOffset || found != tt.wantFound {
+				t.Errorf("FirstNonChinese() = %q, %v, %v, want %q, %v, %v", r, offset, found, tt.wantRune, tt.wantOffset, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestIsChineseIdeographsOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: true,
+		},
+		{
+			s:    "【】（）「」，。",
+			want: true,
+		},
+		{
+			s:    "【ab】，。",
+			want: false,
+		},
+		{
+			s:    "【中文 ab】！！！",
+			want: false,
+		},
+		{
+			s:    "【中文 abc】",
+			want: false,
+		},
+		{
+			s:    "【中文，a】",
+			want: true,
+		},
+		{
+			s:    "中文 ㎡ ㎡ ㎡ ab",
+			want: false,
+		},
+		{
+			s:    "中文㎡ a",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseIdeographsOnly(tt.s); got != tt.want {
+				t.Errorf("IsChineseIdeographsOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !IsChinese("【中文 ab】！！！") {
+		t.Errorf("IsChinese() = %v, want %v", false, true)
+	}
+}
+
+func TestIsMostly(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	simplifiedOrPunct := func(r rune) bool {
+		return IsSimplifiedChineseRune(r) || unicode.IsPunct(r)
+	}
+	tests := []struct {
+		name      string
+		s         string
+		threshold float64
+		want      bool
+		wantAll   bool
+	}{
+		{
+			s:         "",
+			threshold: 0.5,
+			want:      true,
+			wantAll:   true,
+		},
+		{
+			s:         "国家!",
+			threshold: 0.5,
+			want:      true,
+			wantAll:   true,
+		},
+		{
+			s:         "国家!?ab",
+			threshold: 0.5,
+			want:      true,
+			wantAll:   false,
+		},
+		{
+			s:         "国家ab",
+			threshold: 0.5,
+			want:      false,
+			wantAll:   false,
+		},
+		{
+			s:         "國家!",
+			threshold: 0.5,
+			want:      true,
+			wantAll:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMostly(tt.s, simplifiedOrPunct, tt.threshold); got != tt.want {
+				t.Errorf("IsMostly() = %v, want %v", got, tt.want)
+			}
+			if got := IsAll(tt.s, simplifiedOrPunct); got != tt.wantAll {
+				t.Errorf("IsAll() = %v, want %v", got, tt.wantAll)
+			}
+		})
+	}
+}
+
+func TestTraditionalVariants(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		r               rune
+		wantTraditional []rune
+		wantSimplified  []rune
+	}{
+		{
+			r: 'a',
+		},
+		{
+			r: '人',
+		},
+		{
+			r:               '发',
+			wantTraditional: []rune{'發', '髮'},
+		},
+		{
+			r:               '着',
+			wantTraditional: []rune{'着', '著'},
+			wantSimplified:  []rune{'着'},
+		},
+		{
+			r:               '著',
+			wantTraditional: []rune{'著'},
+			wantSimplified:  []rune{'着', '著'},
+		},
+		{
+			r:              '國',
+			wantSimplified: []rune{'国'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TraditionalVariants(tt.r); !reflect.DeepEqual(got, tt.wantTraditional) {
+				t.Errorf("TraditionalVariants() = %q, want %q", got, tt.wantTraditional)
+			}
+			if got := SimplifiedVariants(tt.r); !reflect.DeepEqual(got, tt.wantSimplified) {
+				t.Errorf("SimplifiedVariants() = %q, want %q", got, tt.wantSimplified)
+			}
+		})
+	}
+}
+
+func TestRelatedVariants(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		r    rune
+		want []rune
+	}{
+		{
+			r: 'a',
+		},
+		{
+			r: '中',
+		},
+		// kSemanticVariant with source tags
+		{
+			r:    '乾',
+			want: []rune{'乹', '亁'},
+		},
+		// listed only on the line of 乾
+		{
+			r:    '亁',
+			want: []rune{'乾'},
+		},
+		// kZVariant
+		{
+			r:    '說',
+			want: []rune{'説'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelatedVariants(tt.r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RelatedVariants() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	// related variants do not make 説 simplified or traditional
+	if !IsSimplifiedChineseRune('説') || !IsTraditionalChineseRune('説') {
+		t.Errorf("IsSimplifiedChineseRune('説') = %v, IsTraditionalChineseRune('説') = %v, want shared", IsSimplifiedChineseRune('説'), IsTraditionalChineseRune('説'))
+	}
+}
+
+func TestCompatibilityIdeographs(t *testing.T) {
+	tests := []struct {
+		name string
+		r    rune
+		want bool
+	}{
+		{
+			r:    '\uF8FF',
+			want: false,
+		},
+		{
+			r:    '\uF900',
+			want: true,
+		},
+		{
+			r:    '\uFA0D',
+			want: true,
+		},
+		{
+			r:    '\uFA0E',
+			want: true,
+		},
+		{
+			r:    '\uFA29',
+			want: true,
+		},
+		{
+			r:    '\uFAFF',
+			want: true,
+		},
+		{
+			r:    '\uFB00',
+			want: false,
+		},
+		{
+			r:    '\U0002F7FF',
+			want: false,
+		},
+		{
+			r:    '\U0002F800',
+			want: true,
+		},
+		{
+			r:    '\U0002FA1F',
+			want: true,
+		},
+		{
+			r:    '\U0002FA20',
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isIdeographChar(tt.r); got != tt.want {
+				t.Errorf("isIdeographChar(%U) = %v, want %v", tt.r, got, tt.want)
+			}
+			if got := IsChineseRune(tt.r); got != tt.want {
+				t.Errorf("IsChineseRune(%U) = %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadDictionaryFromFS(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	defer func() {
+		if err := LoadDictionaryFromFS(embedded, "Unihan_Variants.txt"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	fsys := fstest.MapFS{
+		"variants.txt": {Data: []byte("# synthetic\nU+4EBA\tkTraditionalVariant\tU+4EBB\n")},
+		"empty.txt":    {Data: []byte("# nothing\nU+4EBA\tkSemanticVariant\tU+4EBB\n")},
+	}
+	if err := LoadDictionaryFromFS(fsys, "missing.txt"); err == nil {
+		t.Errorf("LoadDictionaryFromFS() = %v, want error", err)
+	}
+	if err := LoadDictionaryFromFS(fsys, "empty.txt"); !errors.Is(err, errEmptyDictionary) {
+		t.Errorf("LoadDictionaryFromFS() = %v, want %v", err, errEmptyDictionary)
+	}
+	if got := IsPureTraditionalChinese("國"); !got {
+		t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, true)
+	}
+	if err := LoadDictionaryFromFS(fsys, "variants.txt"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name            string
+		s               string
+		wantSimplified  bool
+		wantTraditional bool
+	}{
+		{
+			s:               "人",
+			wantSimplified:  true,
+			wantTraditional: false,
+		},
+		{
+			s:               "亻",
+			wantSimplified:  false,
+			wantTraditional: true,
+		},
+		// not in the synthetic file
+		{
+			s:               "國",
+			wantSimplified:  true,
+			wantTraditional: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPureSimplifiedChinese(tt.s); got != tt.wantSimplified {
+				t.Errorf("IsPureSimplifiedChinese() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := IsPureTraditionalChinese(tt.s); got != tt.wantTraditional {
+				t.Errorf("IsPureTraditionalChinese() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+	if got, want := ToTraditional("人"), "亻"; got != want {
+		t.Errorf("ToTraditional() = %v, want %v", got, want)
+	}
+}
+
+func TestValidatePureChinese(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		wantErr *NonChineseError
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "中文，测试。",
+		},
+		{
+			s:       "中文 abc",
+			wantErr: &NonChineseError{Rune: ' ', Offset: 6},
+		},
+		{
+			s:       "こんにちは世界",
+			wantErr: &NonChineseError{Rune: 'こ', Offset: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePureChinese(tt.s)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("ValidatePureChinese() = %v, want nil", err)
+				}
+				return
+			}
+			var got *NonChineseError
+			if !errors.As(err, &got) {
+				t.Fatalf("ValidatePureChinese() = %v, want %v", err, tt.wantErr)
+			}
+			if *got != *tt.wantErr {
+				t.Errorf("ValidatePureChinese() = %+v, want %+v", *got, *tt.wantErr)
+			}
+		})
+	}
+	if got, want := ValidatePureChinese("中a").Error(), `non Chinese unicode 'a' (U+0061) at byte offset 3`; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestIsPureChineseStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    bool
+		wantErr string
+	}{
+		{
+			s:    "",
+			want: true,
+		},
+		{
+			s:    "中文",
+			want: true,
+		},
+		{
+			s:    "中文a",
+			want: false,
+		},
+		{
+			s:    "中文\uFFFD",
+			want: false,
+		},
+		{
+			s:       "中文\xff",
+			wantErr: "invalid UTF-8 at byte offset 6",
+		},
+		{
+			s:       "中\xe6\x96",
+			wantErr: "invalid UTF-8 at byte offset 3",
+		},
+		{
+			// surrogate U+D800
+			s:       "\xed\xa0\x80中",
+			wantErr: "invalid UTF-8 at byte offset 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsPureChineseStrict(tt.s)
+			if tt.wantErr != "" {
+				if !errors.Is(err, errInvalidUTF8) || err.Error() != tt.wantErr {
+					t.Errorf("IsPureChineseStrict() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("IsPureChineseStrict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsChineseIncludingRadicals(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		want        bool
+		wantDefault bool
+	}{
+		{
+			s:           "\u2F00\u2F08\u2FD5",
+			want:        true,
+			wantDefault: false,
+		},
+		{
+			s:           "\u2E80\u2EF3",
+			want:        true,
+			wantDefault: false,
+		},
+		{
+			s:           "\u2F00部",
+			want:        true,
+			wantDefault: false,
+		},
+		{
+			s:           "\u2FD6\u2EF4",
+			want:        false,
+			wantDefault: false,
+		},
+		{
+			s:           "中文",
+			want:        true,
+			wantDefault: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseIncludingRadicals(tt.s); got != tt.want {
+				t.Errorf("IsChineseIncludingRadicals() = %v, want %v", got, tt.want)
+			}
+			if got := IsChinese(tt.s); got != tt.wantDefault {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestDictionaryErr(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	if os.Getenv("ISCHINESE_CORRUPT_DICTIONARY") == "1" {
+		// a line longer than bufio.Scanner accepts
+		dictFS = fstest.MapFS{"Unihan_Variants.txt": {Data: []byte("U+56FD\tkTraditionalVariant\t" + strings.Repeat("U+570B ", 10000))}}
+		if err := DictionaryErr(); !errors.Is(err, bufio.ErrTooLong) {
+			t.Fatalf("DictionaryErr() = %v, want %v", err, bufio.ErrTooLong)
+		}
+		if !IsSimplifiedChinese("國家") || !IsTraditionalChinese("国家") {
+			t.Error("simplified and traditional detection fail to fall back to the Chinese unicode ranges")
+		}
+		return
+	}
+	if err := DictionaryErr(); err != nil {
+		t.Errorf("DictionaryErr() = %v, want nil", err)
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestDictionaryErr$")
+	cmd.Env = append(os.Environ(), "ISCHINESE_CORRUPT_DICTIONARY=1", ignoreDictionaryErrorEnv+"=1")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("%v\n%s", err, out)
+	}
+	// panics without the environment variable
+	cmd = exec.Command(os.Args[0], "-test.run=^TestDictionaryErr$")
+	cmd.Env = append(os.Environ(), "ISCHINESE_CORRUPT_DICTIONARY=1", ignoreDictionaryErrorEnv+"=0")
+	if err := cmd.Run(); err == nil {
+		t.Errorf("corrupt dictionary did not panic without %s", ignoreDictionaryErrorEnv)
+	}
+}
+
+func TestIsNearlyPureChinese(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             string
+		maxNonChinese int
+		want          bool
+	}{
+		{
+			s:             "",
+			maxNonChinese: 0,
+			want:          true,
+		},
+		{
+			s:             "你好，世界",
+			maxNonChinese: 0,
+			want:          true,
+		},
+		{
+			s:             "你好 世界",
+			maxNonChinese: 0,
+			want:          false,
+		},
+		{
+			s:             "你好 世界",
+			maxNonChinese: 1,
+			want:          true,
+		},
+		{
+			s:             "你好 世界 ",
+			maxNonChinese: 1,
+			want:          false,
+		},
+		{
+			s:             "你好 世界 ",
+			maxNonChinese: 2,
+			want:          true,
+		},
+		{
+			s:             "hello",
+			maxNonChinese: 2,
+			want:          false,
+		},
+		{
+			s:             "你好",
+			maxNonChinese: -1,
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNearlyPureChinese(tt.s, tt.maxNonChinese); got != tt.want {
+				t.Errorf("IsNearlyPureChinese() = %v, want %v", got, tt.want)
+			}
+			if tt.maxNonChinese == 0 && tt.want != IsPureChinese(tt.s) {
+				t.Errorf("IsNearlyPureChinese(%q, 0) = %v, but IsPureChinese() = %v", tt.s, tt.want, !tt.want)
+			}
+		})
+	}
+}
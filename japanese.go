@@ -0,0 +1,35 @@
+package ischinese
+
+import "unicode"
+
+// japaneseOnlyKanji ideographs used in Japanese but not in Chinese text:
+// kokuji like 込 or 畑, the iteration marks 々 and 〆, and shinjitai like 駅 (Chinese 站 or 驛) or 気 (Chinese 气 or 氣)
+var japaneseOnlyKanji = makeRuneSet("" +
+	"々〆" +
+	"込畑峠働栃匂搾枠辻" +
+	"気駅図団売読変関験歳戦鉄転伝営単桜沢児県実楽薬")
+
+func isKanaChar(r rune) bool {
+	return unicode.In(r, unicode.Hiragana, unicode.Katakana)
+}
+
+func isJapaneseOnlyKanji(r rune) bool {
+	_, ok := japaneseOnlyKanji[r]
+	return ok
+}
+
+// IsLikelyJapanese true if any unicode code point is Hiragana or Katakana, or a Kanji only used in Japanese, e.g. 駅 or 込.
+// Kana is a reliable signal, while Kanji only Japanese text without one of those Kanji, e.g. 東京, is indistinguishable from Chinese.
+func IsLikelyJapanese(s string) bool {
+	for _, r := range s {
+		if isKanaChar(r) || isJapaneseOnlyKanji(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsChineseLikelyJapanese true if IsChinese is true but IsLikelyJapanese too, i.e. the Chinese verdict is likely Japanese Kanji
+func IsChineseLikelyJapanese(s string) bool {
+	return IsChinese(s) && IsLikelyJapanese(s)
+}
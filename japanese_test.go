@@ -0,0 +1,65 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestIsLikelyJapanese(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		want        bool
+		wantChinese bool
+	}{
+		{
+			s:           "",
+			want:        false,
+			wantChinese: false,
+		},
+		{
+			s:           "こんにちは世界",
+			want:        true,
+			wantChinese: false,
+		},
+		{
+			s:           "東京駅",
+			want:        true,
+			wantChinese: true,
+		},
+		{
+			s:           "日本語の勉強",
+			want:        true,
+			wantChinese: true,
+		},
+		{
+			s:           "人々",
+			want:        true,
+			wantChinese: true,
+		},
+		{
+			s:           "ｶﾀｶﾅ",
+			want:        true,
+			wantChinese: false,
+		},
+		{
+			s:           "北京站",
+			want:        false,
+			wantChinese: false,
+		},
+		{
+			s:           "你很機車哎",
+			want:        false,
+			wantChinese: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLikelyJapanese(tt.s); got != tt.want {
+				t.Errorf("IsLikelyJapanese() = %v, want %v", got, tt.want)
+			}
+			if got := IsChineseLikelyJapanese(tt.s); got != tt.wantChinese {
+				t.Errorf("IsChineseLikelyJapanese() = %v, want %v", got, tt.wantChinese)
+			}
+		})
+	}
+}
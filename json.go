@@ -0,0 +1,41 @@
+package ischinese
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// IsChineseJSON decode JSON data and report IsChinese for every string value by its dotted path,
+// e.g. "user.tags.0" for the first element of the array "tags" in the object "user".
+// Object keys are not checked, a string at the top level has the path "".
+func IsChineseJSON(data []byte) (map[string]bool, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	res := make(map[string]bool)
+	walkJSON(v, "", res)
+	return res, nil
+}
+
+func walkJSON(v interface{}, path string, res map[string]bool) {
+	switch v := v.(type) {
+	case string:
+		res[path] = IsChinese(v)
+	case map[string]interface{}:
+		for k, e := range v {
+			walkJSON(e, joinJSONPath(path, k), res)
+		}
+	case []interface{}:
+		for i, e := range v {
+			walkJSON(e, joinJSONPath(path, strconv.Itoa(i)), res)
+		}
+	}
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,54 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsChineseJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[string]bool
+		wantErr bool
+	}{
+		{
+			data: `{"title": "你好世界", "author": {"name": "Alice", "bio": "一位作家"}, "tags": ["小说", "fiction", {"label": "中文"}], "pages": 100, "draft": false, "note": null}`,
+			want: map[string]bool{
+				"title":        true,
+				"author.name":  false,
+				"author.bio":   true,
+				"tags.0":       true,
+				"tags.1":       false,
+				"tags.2.label": true,
+			},
+		},
+		{
+			data: `["hello", "你好"]`,
+			want: map[string]bool{"0": false, "1": true},
+		},
+		{
+			data: `"你好"`,
+			want: map[string]bool{"": true},
+		},
+		{
+			data: `{}`,
+			want: map[string]bool{},
+		},
+		{
+			data:    `{"title": "你好"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsChineseJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsChineseJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IsChineseJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
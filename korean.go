@@ -0,0 +1,19 @@
+package ischinese
+
+import "unicode"
+
+// IsLikelyKorean true if any unicode code point is Hangul: syllables (U+AC00–U+D7A3), Jamo, compatibility or halfwidth Jamo.
+// Hanja only Korean text, e.g. 大韓民國, is indistinguishable from traditional Chinese.
+func IsLikelyKorean(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Hangul, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsChineseLikelyKorean true if IsChinese is true but IsLikelyKorean too, i.e. the Chinese verdict is likely Hanja heavy Korean
+func IsChineseLikelyKorean(s string) bool {
+	return IsChinese(s) && IsLikelyKorean(s)
+}
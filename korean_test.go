@@ -0,0 +1,60 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestIsLikelyKorean(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		want        bool
+		wantChinese bool
+	}{
+		{
+			s:           "",
+			want:        false,
+			wantChinese: false,
+		},
+		{
+			s:           "안녕하세요",
+			want:        true,
+			wantChinese: false,
+		},
+		{
+			s:           "ㅎㅎ",
+			want:        true,
+			wantChinese: false,
+		},
+		{
+			s:           "大韓民國 헌법",
+			want:        true,
+			wantChinese: true,
+		},
+		{
+			s:           "韓國語 공부",
+			want:        true,
+			wantChinese: false,
+		},
+		{
+			s:           "你好世界",
+			want:        false,
+			wantChinese: false,
+		},
+		{
+			s:           "こんにちは",
+			want:        false,
+			wantChinese: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLikelyKorean(tt.s); got != tt.want {
+				t.Errorf("IsLikelyKorean() = %v, want %v", got, tt.want)
+			}
+			if got := IsChineseLikelyKorean(tt.s); got != tt.wantChinese {
+				t.Errorf("IsChineseLikelyKorean() = %v, want %v", got, tt.wantChinese)
+			}
+		})
+	}
+}
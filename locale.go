@@ -0,0 +1,69 @@
+package ischinese
+
+import "strings"
+
+// MatchesScriptSubtag true if s matches the script subtag of a BCP 47 language tag,
+// i.e. s IsSimplifiedChinese for "Hans" (e.g. "zh-Hans", "zh-Hans-CN") and IsTraditionalChinese for "Hant" (e.g. "zh-Hant-TW").
+// Both use the more than 50% threshold. Tags without a Hans or Hant script subtag, e.g. "zh-TW", never match.
+func MatchesScriptSubtag(s string, tag string) bool {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(subtags) < 2 {
+		return false
+	}
+	for _, subtag := range subtags[1:] {
+		switch strings.ToLower(subtag) {
+		case "hans":
+			return IsSimplifiedChinese(s)
+		case "hant":
+			return IsTraditionalChinese(s)
+		}
+	}
+	return false
+}
+
+// hongKongTaiwanVariants traditional characters written differently in Hong Kong and Taiwan, Hong Kong form first,
+// following the Hong Kong glyph standard (常用字字形表) and the Taiwan standard forms (國字標準字體).
+// Unihan_Variants.txt has no locale data to derive them from: its only locale tag, kHKGlyph, cites the Hong Kong glyph standard
+// as the source of about a hundred kSemanticVariant pairs without telling which character of the pair is the Hong Kong form.
+var hongKongTaiwanVariants = [][2]rune{
+	{'綫', '線'},
+	{'裏', '裡'},
+	{'啓', '啟'},
+	{'着', '著'},
+	{'説', '說'},
+	{'兑', '兌'},
+	{'悦', '悅'},
+	{'税', '稅'},
+	{'脱', '脫'},
+	{'户', '戶'},
+	{'温', '溫'},
+	{'污', '汙'},
+	{'葱', '蔥'},
+	{'峯', '峰'},
+}
+
+var hongKongVariants, taiwanVariants = func() (map[rune]struct{}, map[rune]struct{}) {
+	hk, tw := make(map[rune]struct{}), make(map[rune]struct{})
+	for _, pair := range hongKongTaiwanVariants {
+		hk[pair[0]] = struct{}{}
+		tw[pair[1]] = struct{}{}
+	}
+	return hk, tw
+}()
+
+// IsHongKongVariant true if unicode code point is the Hong Kong form of a traditional character written differently in Taiwan,
+// e.g. 綫 (Taiwan 線) or 裏 (Taiwan 裡). Only a small curated list is covered, as the embedded Unihan data has no locale information,
+// and some Hong Kong forms such as 温 or 户 are simplified Chinese too.
+func IsHongKongVariant(r rune) bool {
+	_, ok := hongKongVariants[r]
+	return ok
+}
+
+// IsTaiwanVariant true if unicode code point is the Taiwan form of a traditional character written differently in Hong Kong,
+// e.g. 線 (Hong Kong 綫) or 裡 (Hong Kong 裏), see IsHongKongVariant
+func IsTaiwanVariant(r rune) bool {
+	_, ok := taiwanVariants[r]
+	return ok
+}
@@ -0,0 +1,119 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestMatchesScriptSubtag(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		tag  string
+		want bool
+	}{
+		{
+			s:    "喜欢锻炼的人",
+			tag:  "zh-Hans",
+			want: true,
+		},
+		{
+			s:    "喜欢锻炼的人",
+			tag:  "zh-Hant",
+			want: false,
+		},
+		{
+			s:    "射鵰英雄傳",
+			tag:  "zh-Hant-TW",
+			want: true,
+		},
+		{
+			s:    "連載說話",
+			tag:  "zh_hans_CN",
+			want: false,
+		},
+		{
+			s:    "射鵰英雄傳",
+			tag:  "zh-TW",
+			want: false,
+		},
+		{
+			s:    "hello world",
+			tag:  "zh-Hans",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchesScriptSubtag(tt.s, tt.tag); got != tt.want {
+				t.Errorf("MatchesScriptSubtag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsHongKongVariant(t *testing.T) {
+	tests := []struct {
+		name         string
+		r            rune
+		wantHongKong bool
+		wantTaiwan   bool
+	}{
+		{
+			r:            '綫',
+			wantHongKong: true,
+			wantTaiwan:   false,
+		},
+		{
+			r:            '線',
+			wantHongKong: false,
+			wantTaiwan:   true,
+		},
+		{
+			r:            '裏',
+			wantHongKong: true,
+			wantTaiwan:   false,
+		},
+		{
+			r:            '裡',
+			wantHongKong: false,
+			wantTaiwan:   true,
+		},
+		{
+			r:            '説',
+			wantHongKong: true,
+			wantTaiwan:   false,
+		},
+		{
+			r:            '說',
+			wantHongKong: false,
+			wantTaiwan:   true,
+		},
+		// same in both
+		{
+			r:            '國',
+			wantHongKong: false,
+			wantTaiwan:   false,
+		},
+		{
+			r:            'a',
+			wantHongKong: false,
+			wantTaiwan:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsHongKongVariant(tt.r); got != tt.wantHongKong {
+				t.Errorf("IsHongKongVariant(%q) = %v, want %v", tt.r, got, tt.wantHongKong)
+			}
+			if got := IsTaiwanVariant(tt.r); got != tt.wantTaiwan {
+				t.Errorf("IsTaiwanVariant(%q) = %v, want %v", tt.r, got, tt.wantTaiwan)
+			}
+		})
+	}
+	for _, pair := range hongKongTaiwanVariants {
+		if pair[0] == pair[1] || !IsChineseRune(pair[0]) || !IsChineseRune(pair[1]) {
+			t.Errorf("hongKongTaiwanVariants has invalid pair %q", string(pair[:]))
+		}
+	}
+}
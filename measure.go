@@ -0,0 +1,31 @@
+package ischinese
+
+// measureWords common Chinese measure words (classifiers) in simplified and traditional forms, not exhaustive
+var measureWords = makeRuneSet("" +
+	"个個只隻张張条條本件位头頭匹辆輛台臺架把支枝根块塊片颗顆粒棵朵双雙对對副套" +
+	"份封篇首部间間座所家层層场場次遍趟顿頓杯瓶碗盘盤群批种種样樣项項节節段句" +
+	"门門道幅艘栋棟扇顶頂盏盞串束堆排届屆名")
+
+func makeRuneSet(s string) map[rune]struct{} {
+	set := make(map[rune]struct{})
+	for _, r := range s {
+		set[r] = struct{}{}
+	}
+	return set
+}
+
+func isMeasureWord(r rune) bool {
+	_, ok := measureWords[r]
+	return ok
+}
+
+// ContainsMeasureWord true if s contains a common measure word like 个/個, 只/隻, 张/張 or 条/條.
+// The set is not exhaustive, and since many measure words are also ordinary characters (e.g. 口, 家), a match only suggests a classifier.
+func ContainsMeasureWord(s string) bool {
+	for _, r := range s {
+		if isMeasureWord(r) {
+			return true
+		}
+	}
+	return false
+}
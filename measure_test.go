@@ -0,0 +1,45 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestContainsMeasureWord(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "hello world",
+			want: false,
+		},
+		{
+			s:    "你好世界",
+			want: false,
+		},
+		{
+			s:    "三个人",
+			want: true,
+		},
+		{
+			s:    "一隻貓",
+			want: true,
+		},
+		{
+			s:    "两條魚",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsMeasureWord(tt.s); got != tt.want {
+				t.Errorf("ContainsMeasureWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
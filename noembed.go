@@ -0,0 +1,12 @@
+//go:build ischinese_noembed
+// +build ischinese_noembed
+
+package ischinese
+
+import "embed"
+
+// hasEmbeddedDictionary false with the build tag ischinese_noembed, see NewDetectorFromReader
+const hasEmbeddedDictionary = false
+
+// embedded empty, the package dictionaries stay empty until LoadDictionaryFromFS
+var embedded embed.FS
@@ -0,0 +1,50 @@
+package ischinese
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizationDiffers true if NFKC normalization changes s, i.e. s is not already in NFKC form,
+// e.g. it contains fullwidth forms like "ＡＢＣ", CJK compatibility ideographs like 豈 (U+F900),
+// or squared forms like ㎡. Text for which it returns false needs no normalization pass.
+func NormalizationDiffers(s string) bool {
+	return !norm.NFKC.IsNormalString(s)
+}
+
+// fullwidthOffset distance between a fullwidth form U+FF01–U+FF5E and its ASCII equivalent U+0021–U+007E
+const fullwidthOffset = '！' - '!'
+
+type normalizeOptions struct {
+	fullwidth bool
+}
+
+// NormalizeOption option of Normalize
+type NormalizeOption func(*normalizeOptions)
+
+// WithFullwidth convert ASCII to fullwidth forms instead, default false
+func WithFullwidth(fullwidth bool) NormalizeOption {
+	return func(o *normalizeOptions) {
+		o.fullwidth = fullwidth
+	}
+}
+
+// Normalize convert fullwidth forms U+FF01–U+FF5E, e.g. "１２３，ＡＢＣ", to ASCII U+0021–U+007E, e.g. "123,ABC",
+// or the other way round WithFullwidth(true). Other unicode code points, including spaces, are left untouched.
+// It is separate from detection: fullwidth punctuation is Chinese unicode and ASCII is not, so normalize before the Is functions as needed.
+func Normalize(s string, opts ...NormalizeOption) string {
+	var o normalizeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case !o.fullwidth && '！' <= r && r <= '～':
+			return r - fullwidthOffset
+		case o.fullwidth && '!' <= r && r <= '~':
+			return r + fullwidthOffset
+		}
+		return r
+	}, s)
+}
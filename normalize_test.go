@@ -0,0 +1,84 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestNormalizationDiffers(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "你好，世界 hello",
+			want: true,
+		},
+		{
+			s:    "你好。世界 hello",
+			want: false,
+		},
+		{
+			s:    "ＡＢＣ",
+			want: true,
+		},
+		{
+			s:    "豈",
+			want: true,
+		},
+		{
+			s:    "㎡",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizationDiffers(tt.s); got != tt.want {
+				t.Errorf("NormalizationDiffers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		fullwidth bool
+		want      string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "１２３，ＡＢＣ",
+			want: "123,ABC",
+		},
+		{
+			s:    "你好！ｈｅｌｌｏ～　世界。",
+			want: "你好!hello~　世界。",
+		},
+		{
+			s:         "123,ABC",
+			fullwidth: true,
+			want:      "１２３，ＡＢＣ",
+		},
+		{
+			s:         "你好! hello",
+			fullwidth: true,
+			want:      "你好！ ｈｅｌｌｏ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.s, WithFullwidth(tt.fullwidth)); got != tt.want {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
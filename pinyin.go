@@ -0,0 +1,70 @@
+package ischinese
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// pinyinToneVowels vowels with the four tone marks, in tone order, and ü with and without tone marks, upper and lower case
+var pinyinToneVowels = makeRuneSet("" +
+	"āáǎà" + "ēéěè" + "īíǐì" + "ōóǒò" + "ūúǔù" + "ǖǘǚǜü" +
+	"ĀÁǍÀ" + "ĒÉĚÈ" + "ĪÍǏÌ" + "ŌÓǑÒ" + "ŪÚǓÙ" + "ǕǗǙǛÜ")
+
+func isPinyinToneVowel(r rune) bool {
+	_, ok := pinyinToneVowels[r]
+	return ok
+}
+
+func isASCIILetter(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
+}
+
+// IsPinyin true if s is Pinyin with tone marks, e.g. "nǐ hǎo": every token consists of ASCII letters, the vowels in
+// pinyinToneVowels and apostrophes (xī'ān), and at least one vowel has a tone mark or is ü, which tells Pinyin from English.
+// Tokens are separated by whitespace and punctuation, s is NFC normalized first so that combining tone marks are accepted.
+// It checks letters, not syllables, so a made up word with a tone mark is accepted too.
+func IsPinyin(s string) bool {
+	tokens := strings.FieldsFunc(norm.NFC.String(s), func(r rune) bool {
+		return unicode.IsSpace(r) || (unicode.IsPunct(r) && r != '\'')
+	})
+	if len(tokens) == 0 {
+		return false
+	}
+	var marked bool
+	for _, token := range tokens {
+		for _, r := range token {
+			switch {
+			case isPinyinToneVowel(r):
+				marked = true
+			case isASCIILetter(r), r == '\'':
+			default:
+				return false
+			}
+		}
+	}
+	return marked
+}
+
+// numberedPinyinRegexp one or more syllables of letters, ü written as ü or u:, each followed by an optional tone number 1–5
+var numberedPinyinRegexp = regexp.MustCompile(`^(?:(?:[a-zA-ZüÜ]|u:)+[1-5]?)+$`)
+
+// IsNumberedPinyin true if s is Pinyin with tone numbers, e.g. "ni3 hao3" or "zhong1guo2 lu:4": every token separated by
+// whitespace matches numberedPinyinRegexp, and at least one tone number is present, which tells Pinyin from English.
+// Syllables are loosely letter sequences, initials and finals are not checked.
+func IsNumberedPinyin(s string) bool {
+	tokens := strings.Fields(s)
+	if len(tokens) == 0 {
+		return false
+	}
+	var numbered bool
+	for _, token := range tokens {
+		if !numberedPinyinRegexp.MatchString(token) {
+			return false
+		}
+		numbered = numbered || strings.ContainsAny(token, "12345")
+	}
+	return numbered
+}
@@ -0,0 +1,126 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestIsPinyin(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "nǐ hǎo",
+			want: true,
+		},
+		{
+			s:    "Nǐ hǎo, shìjiè!",
+			want: true,
+		},
+		{
+			s:    "lǜsè de Xī'ān",
+			want: true,
+		},
+		{
+			s:    "ni\u030C ha\u030Co", // combining caron
+			want: true,
+		},
+		{
+			s:    "hello world",
+			want: false,
+		},
+		{
+			s:    "ni hao",
+			want: false,
+		},
+		{
+			s:    "nǐ hǎo 你好",
+			want: false,
+		},
+		{
+			s:    "nǐ hǎo 2021",
+			want: false,
+		},
+		// letters, not syllables
+		{
+			s:    "café",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPinyin(tt.s); got != tt.want {
+				t.Errorf("IsPinyin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNumberedPinyin(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{
+			s:    "",
+			want: false,
+		},
+		{
+			s:    "ni3 hao3",
+			want: true,
+		},
+		{
+			s:    "zhong1 guo2",
+			want: true,
+		},
+		{
+			s:    "zhong1guo2 ren2",
+			want: true,
+		},
+		{
+			s:    "lu:4 se4 de5",
+			want: true,
+		},
+		{
+			s:    "Bei3jing1 huan1ying2 ni",
+			want: true,
+		},
+		{
+			s:    "hello world",
+			want: false,
+		},
+		{
+			s:    "ni6 hao3",
+			want: false,
+		},
+		{
+			s:    "ni33 hao3",
+			want: false,
+		},
+		{
+			s:    "3ni hao",
+			want: false,
+		},
+		{
+			s:    "ni3, hao3",
+			want: false,
+		},
+		{
+			s:    "nǐ hǎo",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNumberedPinyin(tt.s); got != tt.want {
+				t.Errorf("IsNumberedPinyin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package ischinese
+
+import "strings"
+
+// radicalIdeographs map Kangxi Radicals (U+2F00–U+2FD5) and the CJK Radicals Supplement code points
+// with a compatibility decomposition (U+2E9F, U+2EF3) to their equivalent CJK unified ideographs
+var radicalIdeographs = map[rune]rune{
+	'\u2E9F': '\u6BCD', // ⺟ 母
+	'\u2EF3': '\u9F9F', // ⻳ 龟
+	'\u2F00': '\u4E00', // ⼀ 一
+	'\u2F01': '\u4E28', // ⼁ 丨
+	'\u2F02': '\u4E36', // ⼂ 丶
+	'\u2F03': '\u4E3F', // ⼃ 丿
+	'\u2F04': '\u4E59', // ⼄ 乙
+	'\u2F05': '\u4E85', // ⼅ 亅
+	'\u2F06': '\u4E8C', // ⼆ 二
+	'\u2F07': '\u4EA0', // ⼇ 亠
+	'\u2F08': '\u4EBA', // ⼈ 人
+	'\u2F09': '\u513F', // ⼉ 儿
+	'\u2F0A': '\u5165', // ⼊ 入
+	'\u2F0B': '\u516B', // ⼋ 八
+	'\u2F0C': '\u5182', // ⼌ 冂
+	'\u2F0D': '\u5196', // ⼍ 冖
+	'\u2F0E': '\u51AB', // ⼎ 冫
+	'\u2F0F': '\u51E0', // ⼏ 几
+	'\u2F10': '\u51F5', // ⼐ 凵
+	'\u2F11': '\u5200', // ⼑ 刀
+	'\u2F12': '\u529B', // ⼒ 力
+	'\u2F13': '\u52F9', // ⼓ 勹
+	'\u2F14': '\u5315', // ⼔ 匕
+	'\u2F15': '\u531A', // ⼕ 匚
+	'\u2F16': '\u5338', // ⼖ 匸
+	'\u2F17': '\u5341', // ⼗ 十
+	'\u2F18': '\u535C', // ⼘ 卜
+	'\u2F19': '\u5369', // ⼙ 卩
+	'\u2F1A': '\u5382', // ⼚ 厂
+	'\u2F1B': '\u53B6', // ⼛ 厶
+	'\u2F1C': '\u53C8', // ⼜ 又
+	'\u2F1D': '\u53E3', // ⼝ 口
+	'\u2F1E': '\u56D7', // ⼞ 囗
+	'\u2F1F': '\u571F', // ⼟ 土
+	'\u2F20': '\u58EB', // ⼠ 士
+	'\u2F21': '\u5902', // ⼡ 夂
+	'\u2F22': '\u590A', // ⼢ 夊
+	'\u2F23': '\u5915', // ⼣ 夕
+	'\u2F24': '\u5927', // ⼤ 大
+	'\u2F25': '\u5973', // ⼥ 女
+	'\u2F26': '\u5B50', // ⼦ 子
+	'\u2F27': '\u5B80', // ⼧ 宀
+	'\u2F28': '\u5BF8', // ⼨ 寸
+	'\u2F29': '\u5C0F', // ⼩ 小
+	'\u2F2A': '\u5C22', // ⼪ 尢
+	'\u2F2B': '\u5C38', // ⼫ 尸
+	'\u2F2C': '\u5C6E', // ⼬ 屮
+	'\u2F2D': '\u5C71', // ⼭ 山
+	'\u2F2E': '\u5DDB', // ⼮ 巛
+	'\u2F2F': '\u5DE5', // ⼯ 工
+	'\u2F30': '\u5DF1', // ⼰ 己
+	'\u2F31': '\u5DFE', // ⼱ 巾
+	'\u2F32': '\u5E72', // ⼲ 干
+	'\u2F33': '\u5E7A', // ⼳ 幺
+	'\u2F34': '\u5E7F', // ⼴ 广
+	'\u2F35': '\u5EF4', // ⼵ 廴
+	'\u2F36': '\u5EFE', // ⼶ 廾
+	'\u2F37': '\u5F0B', // ⼷ 弋
+	'\u2F38': '\u5F13', // ⼸ 弓
+	'\u2F39': '\u5F50', // ⼹ 彐
+	'\u2F3A': '\u5F61', // ⼺ 彡
+	'\u2F3B': '\u5F73', // ⼻ 彳
+	'\u2F3C': '\u5FC3', // ⼼ 心
+	'\u2F3D': '\u6208', // ⼽ 戈
+	'\u2F3E': '\u6236', // ⼾ 戶
+	'\u2F3F': '\u624B', // ⼿ 手
+	'\u2F40': '\u652F', // ⽀ 支
+	'\u2F41': '\u6534', // ⽁ 攴
+	'\u2F42': '\u6587', // ⽂ 文
+	'\u2F43': '\u6597', // ⽃ 斗
+	'\u2F44': '\u65A4', // ⽄ 斤
+	'\u2F45': '\u65B9', // ⽅ 方
+	'\u2F46': '\u65E0', // ⽆ 无
+	'\u2F47': '\u65E5', // ⽇ 日
+	'\u2F48': '\u66F0', // ⽈ 曰
+	'\u2F49': '\u6708', // ⽉ 月
+	'\u2F4A': '\u6728', // ⽊ 木
+	'\u2F4B': '\u6B20', // ⽋ 欠
+	'\u2F4C': '\u6B62', // ⽌ 止
+	'\u2F4D': '\u6B79', // ⽍ 歹
+	'\u2F4E': '\u6BB3', // ⽎ 殳
+	'\u2F4F': '\u6BCB', // ⽏ 毋
+	'\u2F50': '\u6BD4', // ⽐ 比
+	'\u2F51': '\u6BDB', // ⽑ 毛
+	'\u2F52': '\u6C0F', // ⽒ 氏
+	'\u2F53': '\u6C14', // ⽓ 气
+	'\u2F54': '\u6C34', // ⽔ 水
+	'\u2F55': '\u706B', // ⽕ 火
+	'\u2F56': '\u722A', // ⽖ 爪
+	'\u2F57': '\u7236', // ⽗ 父
+	'\u2F58': '\u723B', // ⽘ 爻
+	'\u2F59': '\u723F', // ⽙ 爿
+	'\u2F5A': '\u7247', // ⽚ 片
+	'\u2F5B': '\u7259', // ⽛ 牙
+	'\u2F5C': '\u725B', // ⽜ 牛
+	'\u2F5D': '\u72AC', // ⽝ 犬
+	'\u2F5E': '\u7384', // ⽞ 玄
+	'\u2F5F': '\u7389', // ⽟ 玉
+	'\u2F60': '\u74DC', // ⽠ 瓜
+	'\u2F61': '\u74E6', // ⽡ 瓦
+	'\u2F62': '\u7518', // ⽢ 甘
+	'\u2F63': '\u751F', // ⽣ 生
+	'\u2F64': '\u7528', // ⽤ 用
+	'\u2F65': '\u7530', // ⽥ 田
+	'\u2F66': '\u758B', // ⽦ 疋
+	'\u2F67': '\u7592', // ⽧ 疒
+	'\u2F68': '\u7676', // ⽨ 癶
+	'\u2F69': '\u767D', // ⽩ 白
+	'\u2F6A': '\u76AE', // ⽪ 皮
+	'\u2F6B': '\u76BF', // ⽫ 皿
+	'\u2F6C': '\u76EE', // ⽬ 目
+	'\u2F6D': '\u77DB', // ⽭ 矛
+	'\u2F6E': '\u77E2', // ⽮ 矢
+	'\u2F6F': '\u77F3', // ⽯ 石
+	'\u2F70': '\u793A', // ⽰ 示
+	'\u2F71': '\u79B8', // ⽱ 禸
+	'\u2F72': '\u79BE', // ⽲ 禾
+	'\u2F73': '\u7A74', // ⽳ 穴
+	'\u2F74': '\u7ACB', // ⽴ 立
+	'\u2F75': '\u7AF9', // ⽵ 竹
+	'\u2F76': '\u7C73', // ⽶ 米
+	'\u2F77': '\u7CF8', // ⽷ 糸
+	'\u2F78': '\u7F36', // ⽸ 缶
+	'\u2F79': '\u7F51', // ⽹ 网
+	'\u2F7A': '\u7F8A', // ⽺ 羊
+	'\u2F7B': '\u7FBD', // ⽻ 羽
+	'\u2F7C': '\u8001', // ⽼ 老
+	'\u2F7D': '\u800C', // ⽽ 而
+	'\u2F7E': '\u8012', // ⽾ 耒
+	'\u2F7F': '\u8033', // ⽿ 耳
+	'\u2F80': '\u807F', // ⾀ 聿
+	'\u2F81': '\u8089', // ⾁ 肉
+	'\u2F82': '\u81E3', // ⾂ 臣
+	'\u2F83': '\u81EA', // ⾃ 自
+	'\u2F84': '\u81F3', // ⾄ 至
+	'\u2F85': '\u81FC', // ⾅ 臼
+	'\u2F86': '\u820C', // ⾆ 舌
+	'\u2F87': '\u821B', // ⾇ 舛
+	'\u2F88': '\u821F', // ⾈ 舟
+	'\u2F89': '\u826E', // ⾉ 艮
+	'\u2F8A': '\u8272', // ⾊ 色
+	'\u2F8B': '\u8278', // ⾋ 艸
+	'\u2F8C': '\u864D', // ⾌ 虍
+	'\u2F8D': '\u866B', // ⾍ 虫
+	'\u2F8E': '\u8840', // ⾎ 血
+	'\u2F8F': '\u884C', // ⾏ 行
+	'\u2F90': '\u8863', // ⾐ 衣
+	'\u2F91': '\u897E', // ⾑ 襾
+	'\u2F92': '\u898B', // ⾒ 見
+	'\u2F93': '\u89D2', // ⾓ 角
+	'\u2F94': '\u8A00', // ⾔ 言
+	'\u2F95': '\u8C37', // ⾕ 谷
+	'\u2F96': '\u8C46', // ⾖ 豆
+	'\u2F97': '\u8C55', // ⾗ 豕
+	'\u2F98': '\u8C78', // ⾘ 豸
+	'\u2F99': '\u8C9D', // ⾙ 貝
+	'\u2F9A': '\u8D64', // ⾚ 赤
+	'\u2F9B': '\u8D70', // ⾛ 走
+	'\u2F9C': '\u8DB3', // ⾜ 足
+	'\u2F9D': '\u8EAB', // ⾝ 身
+	'\u2F9E': '\u8ECA', // ⾞ 車
+	'\u2F9F': '\u8F9B', // ⾟ 辛
+	'\u2FA0': '\u8FB0', // ⾠ 辰
+	'\u2FA1': '\u8FB5', // ⾡ 辵
+	'\u2FA2': '\u9091', // ⾢ 邑
+	'\u2FA3': '\u9149', // ⾣ 酉
+	'\u2FA4': '\u91C6', // ⾤ 釆
+	'\u2FA5': '\u91CC', // ⾥ 里
+	'\u2FA6': '\u91D1', // ⾦ 金
+	'\u2FA7': '\u9577', // ⾧ 長
+	'\u2FA8': '\u9580', // ⾨ 門
+	'\u2FA9': '\u961C', // ⾩ 阜
+	'\u2FAA': '\u96B6', // ⾪ 隶
+	'\u2FAB': '\u96B9', // ⾫ 隹
+	'\u2FAC': '\u96E8', // ⾬ 雨
+	'\u2FAD': '\u9751', // ⾭ 靑
+	'\u2FAE': '\u975E', // ⾮ 非
+	'\u2FAF': '\u9762', // ⾯ 面
+	'\u2FB0': '\u9769', // ⾰ 革
+	'\u2FB1': '\u97CB', // ⾱ 韋
+	'\u2FB2': '\u97ED', // ⾲ 韭
+	'\u2FB3': '\u97F3', // ⾳ 音
+	'\u2FB4': '\u9801', // ⾴ 頁
+	'\u2FB5': '\u98A8', // ⾵ 風
+	'\u2FB6': '\u98DB', // ⾶ 飛
+	'\u2FB7': '\u98DF', // ⾷ 食
+	'\u2FB8': '\u9996', // ⾸ 首
+	'\u2FB9': '\u9999', // ⾹ 香
+	'\u2FBA': '\u99AC', // ⾺ 馬
+	'\u2FBB': '\u9AA8', // ⾻ 骨
+	'\u2FBC': '\u9AD8', // ⾼ 高
+	'\u2FBD': '\u9ADF', // ⾽ 髟
+	'\u2FBE': '\u9B25', // ⾾ 鬥
+	'\u2FBF': '\u9B2F', // ⾿ 鬯
+	'\u2FC0': '\u9B32', // ⿀ 鬲
+	'\u2FC1': '\u9B3C', // ⿁ 鬼
+	'\u2FC2': '\u9B5A', // ⿂ 魚
+	'\u2FC3': '\u9CE5', // ⿃ 鳥
+	'\u2FC4': '\u9E75', // ⿄ 鹵
+	'\u2FC5': '\u9E7F', // ⿅ 鹿
+	'\u2FC6': '\u9EA5', // ⿆ 麥
+	'\u2FC7': '\u9EBB', // ⿇ 麻
+	'\u2FC8': '\u9EC3', // ⿈ 黃
+	'\u2FC9': '\u9ECD', // ⿉ 黍
+	'\u2FCA': '\u9ED1', // ⿊ 黑
+	'\u2FCB': '\u9EF9', // ⿋ 黹
+	'\u2FCC': '\u9EFD', // ⿌ 黽
+	'\u2FCD': '\u9F0E', // ⿍ 鼎
+	'\u2FCE': '\u9F13', // ⿎ 鼓
+	'\u2FCF': '\u9F20', // ⿏ 鼠
+	'\u2FD0': '\u9F3B', // ⿐ 鼻
+	'\u2FD1': '\u9F4A', // ⿑ 齊
+	'\u2FD2': '\u9F52', // ⿒ 齒
+	'\u2FD3': '\u9F8D', // ⿓ 龍
+	'\u2FD4': '\u9F9C', // ⿔ 龜
+	'\u2FD5': '\u9FA0', // ⿕ 龠
+}
+
+// NormalizeRadicals replace Kangxi radical code points with their equivalent CJK unified ideographs, e.g. ⾨ (U+2FA8) with 門 (U+9580)
+func NormalizeRadicals(s string) string {
+	return strings.Map(func(r rune) rune {
+		if ideograph, ok := radicalIdeographs[r]; ok {
+			return ideograph
+		}
+		return r
+	}, s)
+}
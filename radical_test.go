@@ -0,0 +1,33 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestNormalizeRadicals(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "hello 你好",
+			want: "hello 你好",
+		},
+		{
+			s:    "⼀⾨⻳⺟",
+			want: "一門龟母",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeRadicals(tt.s); got != tt.want {
+				t.Errorf("NormalizeRadicals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,496 @@
+// Code generated by go test -run TestRadicalStrokeTable -irgsources Unihan_IRGSources.txt; DO NOT EDIT.
+
+package ischinese
+
+// radicalStrokeTable primary Kangxi radical of CJK ideographs in kRSUnicode, a simplified radical form as its Kangxi radical.
+// Each entry covers the code points up to the next one, radical 0 for none.
+var radicalStrokeTable = []radicalStart{
+	{0x3400, 1}, {0x3403, 2}, {0x3405, 4}, {0x3407, 5}, {0x3427, 6}, {0x3429, 7},
+	{0x342A, 8}, {0x3430, 9}, {0x34AB, 10}, {0x34B0, 11}, {0x34B5, 12}, {0x34BA, 13},
+	{0x34C0, 14}, {0x34C5, 15}, {0x34D8, 16}, {0x34D9, 17}, {0x34DA, 18}, {0x3513, 19},
+	{0x3528, 20}, {0x352B, 21}, {0x352E, 130}, {0x352F, 22}, {0x3537, 23}, {0x3539, 24},
+	{0x353D, 25}, {0x353E, 26}, {0x3542, 27}, {0x3555, 28}, {0x355A, 29}, {0x3562, 154},
+	{0x3563, 30}, {0x361D, 31}, {0x361E, 19}, {0x361F, 31}, {0x3626, 32}, {0x3683, 33},
+	{0x3685, 34}, {0x3686, 35}, {0x3688, 36}, {0x368E, 37}, {0x36A2, 38}, {0x373D, 39},
+	{0x3749, 40}, {0x3773, 41}, {0x3778, 42}, {0x377C, 43}, {0x378B, 44}, {0x37A2, 45},
+	{0x37A4, 46}, {0x37B7, 45}, {0x37B8, 46}, {0x3829, 47}, {0x382A, 48}, {0x382F, 49},
+	{0x3832, 50}, {0x386B, 52}, {0x386F, 53}, {0x389F, 54}, {0x38A1, 55}, {0x38A4, 56},
+	{0x38A7, 57}, {0x38C7, 58}, {0x38C9, 59}, {0x38D4, 60}, {0x38FA, 61}, {0x39AE, 62},
+	{0x39BE, 63}, {0x39C3, 64}, {0x3A7A, 65}, {0x3A7F, 66}, {0x3AAF, 67}, {0x3AB2, 68},
+	{0x3ABC, 69}, {0x3AC3, 70}, {0x3AD0, 72}, {0x3B30, 73}, {0x3B33, 74}, {0x3B39, 130},
+	{0x3B3A, 74}, {0x3B41, 75}, {0x3BA1, 86}, {0x3BA2, 75}, {0x3C1D, 76}, {0x3C37, 78},
+	{0x3C38, 76}, {0x3C4F, 77}, {0x3C59, 78}, {0x3C7C, 79}, {0x3C8B, 81}, {0x3C8C, 82},
+	{0x3CB3, 83}, {0x3CB4, 84}, {0x3CB8, 85}, {0x3DA1, 86}, {0x3E12, 87}, {0x3E16, 88},
+	{0x3E1A, 89}, {0x3E1B, 90}, {0x3E1D, 91}, {0x3E26, 92}, {0x3E28, 93}, {0x3E5C, 94},
+	{0x3EA8, 96}, {0x3F09, 97}, {0x3F17, 98}, {0x3F4D, 99}, {0x3F52, 100}, {0x3F55, 102},
+	{0x3F70, 103}, {0x3F71, 104}, {0x3FDD, 106}, {0x3FEA, 107}, {0x3FFB, 108}, {0x400E, 109},
+	{0x4086, 110}, {0x408F, 111}, {0x4096, 112}, {0x40FC, 113}, {0x4126, 115}, {0x4191, 116},
+	{0x41C2, 117}, {0x41D6, 118}, {0x4275, 184}, {0x4276, 118}, {0x427A, 119}, {0x42B5, 120},
+	{0x4342, 121}, {0x434F, 122}, {0x4367, 123}, {0x437E, 124}, {0x439B, 125}, {0x439F, 126},
+	{0x43A2, 127}, {0x43B2, 128}, {0x43CB, 129}, {0x43CC, 130}, {0x4450, 131}, {0x4452, 133},
+	{0x4454, 134}, {0x4459, 135}, {0x445D, 136}, {0x4460, 137}, {0x448A, 139}, {0x4491, 140},
+	{0x4588, 141}, {0x459D, 142}, {0x460F, 143}, {0x4615, 144}, {0x461A, 145}, {0x4672, 146},
+	{0x4677, 147}, {0x4697, 148}, {0x46AE, 149}, {0x472A, 150}, {0x4733, 151}, {0x4745, 152},
+	{0x4756, 153}, {0x4767, 154}, {0x4791, 155}, {0x4796, 156}, {0x47D3, 157}, {0x4832, 158},
+	{0x4842, 159}, {0x4883, 160}, {0x4885, 161}, {0x488A, 162}, {0x48B3, 163}, {0x48E5, 164},
+	{0x491A, 166}, {0x491B, 167}, {0x4987, 168}, {0x498C, 169}, {0x49B9, 170}, {0x49F1, 172},
+	{0x4A00, 119}, {0x4A01, 172}, {0x4A0B, 173}, {0x4A3C, 174}, {0x4A3D, 175}, {0x4A42, 176},
+	{0x4A50, 177}, {0x4A8F, 178}, {0x4A9E, 179}, {0x4AA6, 180}, {0x4AB1, 181}, {0x4AF8, 182},
+	{0x4B20, 183}, {0x4B22, 184}, {0x4B6B, 185}, {0x4B6F, 186}, {0x4B74, 187}, {0x4BC6, 188},
+	{0x4BE7, 189}, {0x4BED, 190}, {0x4C17, 191}, {0x4C19, 193}, {0x4C1F, 194}, {0x4C32, 195},
+	{0x4CA5, 196}, {0x4D1A, 197}, {0x4D1F, 198}, {0x4D2C, 199}, {0x4D47, 200}, {0x4D4A, 201},
+	{0x4D51, 202}, {0x4D5D, 203}, {0x4D76, 205}, {0x4D7A, 206}, {0x4D7D, 207}, {0x4D82, 208},
+	{0x4D8A, 209}, {0x4D92, 210}, {0x4D94, 211}, {0x4DAC, 212}, {0x4DAF, 213}, {0x4DB3, 214},
+	{0x4DB6, 1}, {0x4DB7, 30}, {0x4DB9, 45}, {0x4DBA, 74}, {0x4DBE, 76}, {0x4DBF, 184},
+	{0x4DC0, 0}, {0x4E00, 1}, {0x4E28, 2}, {0x4E2C, 90}, {0x4E2D, 2}, {0x4E36, 3},
+	{0x4E3D, 1}, {0x4E3E, 3}, {0x4E3F, 4}, {0x4E49, 3}, {0x4E4A, 4}, {0x4E59, 5},
+	{0x4E61, 52}, {0x4E62, 46}, {0x4E63, 5}, {0x4E85, 6}, {0x4E8C, 7}, {0x4E90, 1},
+	{0x4E91, 7}, {0x4EA0, 8}, {0x4EBA, 9}, {0x513F, 10}, {0x5165, 11}, {0x516B, 12},
+	{0x5182, 13}, {0x5196, 14}, {0x51AB, 15}, {0x51E0, 16}, {0x51F5, 17}, {0x5200, 18},
+	{0x529B, 19}, {0x52D7, 72}, {0x52D8, 19}, {0x52F9, 20}, {0x5315, 21}, {0x531A, 22},
+	{0x5338, 23}, {0x5341, 24}, {0x5344, 1}, {0x5345, 24}, {0x535C, 25}, {0x5369, 26},
+	{0x5382, 27}, {0x53B6, 28}, {0x53C8, 29}, {0x53E3, 30}, {0x555F, 66}, {0x5560, 30},
+	{0x5655, 87}, {0x5656, 30}, {0x56D7, 31}, {0x571F, 32}, {0x58A8, 203}, {0x58A9, 32},
+	{0x58E1, 29}, {0x58E2, 32}, {0x58EB, 33}, {0x5902, 34}, {0x5909, 35}, {0x5915, 36},
+	{0x5927, 37}, {0x5973, 38}, {0x5A04, 119}, {0x5A05, 38}, {0x5B50, 39}, {0x5B80, 40},
+	{0x5BF8, 41}, {0x5C0F, 42}, {0x5C22, 43}, {0x5C38, 44}, {0x5C39, 4}, {0x5C3A, 44},
+	{0x5C6E, 45}, {0x5C71, 46}, {0x5DDB, 47}, {0x5DE1, 162}, {0x5DE2, 47}, {0x5DE5, 48},
+	{0x5DF1, 49}, {0x5DFE, 50}, {0x5E50, 130}, {0x5E51, 50}, {0x5E72, 51}, {0x5E7A, 52},
+	{0x5E7F, 53}, {0x5EF4, 54}, {0x5EFE, 55}, {0x5F0B, 56}, {0x5F13, 57}, {0x5F50, 58},
+	{0x5F61, 59}, {0x5F6A, 141}, {0x5F6B, 59}, {0x5F73, 60}, {0x5FC3, 61}, {0x6208, 62},
+	{0x6236, 63}, {0x624B, 64}, {0x652F, 65}, {0x6534, 66}, {0x6587, 67}, {0x6597, 68},
+	{0x65A4, 69}, {0x65B9, 70}, {0x65E0, 71}, {0x65E5, 72}, {0x66F0, 73}, {0x66FD, 72},
+	{0x66FE, 73}, {0x6700, 13}, {0x6701, 73}, {0x6708, 74}, {0x6711, 130}, {0x6712, 74},
+	{0x6721, 130}, {0x6722, 74}, {0x6725, 130}, {0x6726, 74}, {0x6728, 75}, {0x6B20, 76},
+	{0x6B62, 77}, {0x6B79, 78}, {0x6BB3, 79}, {0x6BCB, 80}, {0x6BD4, 81}, {0x6BDB, 82},
+	{0x6C0F, 83}, {0x6C14, 84}, {0x6C34, 85}, {0x6C3D, 11}, {0x6C3E, 85}, {0x6CF5, 112},
+	{0x6CF6, 85}, {0x706B, 86}, {0x722A, 87}, {0x7236, 88}, {0x723B, 89}, {0x723F, 90},
+	{0x7247, 91}, {0x7259, 92}, {0x725B, 93}, {0x72AC, 94}, {0x7384, 95}, {0x7389, 96},
+	{0x74DC, 97}, {0x74E6, 98}, {0x7518, 99}, {0x751F, 100}, {0x7528, 101}, {0x7530, 102},
+	{0x7560, 106}, {0x7561, 102}, {0x7568, 119}, {0x7569, 102}, {0x758B, 103}, {0x7592, 104},
+	{0x7676, 105}, {0x767D, 106}, {0x76AE, 107}, {0x76BF, 108}, {0x76EE, 109}, {0x77DB, 110},
+	{0x77E2, 111}, {0x77F3, 112}, {0x793A, 113}, {0x79B8, 114}, {0x79BE, 115}, {0x7A74, 116},
+	{0x7ACB, 117}, {0x7ADF, 180}, {0x7AE1, 117}, {0x7AF9, 118}, {0x7C73, 119}, {0x7CF8, 120},
+	{0x7F36, 121}, {0x7F51, 122}, {0x7F8A, 123}, {0x7FBD, 124}, {0x8001, 125}, {0x800C, 126},
+	{0x8012, 127}, {0x8033, 128}, {0x807F, 129}, {0x8089, 130}, {0x81A4, 74}, {0x81A5, 130},
+	{0x81E3, 131}, {0x81EA, 132}, {0x81F3, 133}, {0x81FC, 134}, {0x820C, 135}, {0x821B, 136},
+	{0x821F, 137}, {0x826E, 138}, {0x8272, 139}, {0x8278, 140}, {0x842C, 114}, {0x842D, 140},
+	{0x84B8, 86}, {0x84B9, 140}, {0x864D, 141}, {0x8652, 27}, {0x8653, 141}, {0x866B, 142},
+	{0x8840, 143}, {0x884C, 144}, {0x8863, 145}, {0x897E, 146}, {0x898B, 147}, {0x8996, 113},
+	{0x8997, 147}, {0x89C6, 113}, {0x89C7, 147}, {0x89D2, 148}, {0x8A00, 149}, {0x8C37, 150},
+	{0x8C46, 151}, {0x8C55, 152}, {0x8C78, 153}, {0x8C9D, 154}, {0x8D01, 66}, {0x8D02, 154},
+	{0x8D64, 155}, {0x8D70, 156}, {0x8DB3, 157}, {0x8EAB, 158}, {0x8ECA, 159}, {0x8F9B, 160},
+	{0x8FB0, 161}, {0x8FB5, 162}, {0x9091, 163}, {0x9149, 164}, {0x91C6, 165}, {0x91CC, 166},
+	{0x91D1, 167}, {0x9577, 168}, {0x9580, 169}, {0x961C, 170}, {0x96B6, 171}, {0x96B9, 172},
+	{0x96E8, 173}, {0x9751, 174}, {0x975E, 175}, {0x9762, 176}, {0x9769, 177}, {0x97CB, 178},
+	{0x97ED, 179}, {0x97F3, 180}, {0x9801, 181}, {0x98A8, 182}, {0x98DB, 183}, {0x98DF, 184},
+	{0x9996, 185}, {0x9999, 186}, {0x99AC, 187}, {0x9AA8, 188}, {0x9AD8, 189}, {0x9ADF, 190},
+	{0x9B25, 191}, {0x9B2F, 192}, {0x9B32, 193}, {0x9B3C, 194}, {0x9B5A, 195}, {0x9CE5, 196},
+	{0x9D64, 148}, {0x9D65, 196}, {0x9DE0, 195}, {0x9DE1, 196}, {0x9E75, 197}, {0x9E7F, 198},
+	{0x9EA5, 199}, {0x9EBB, 200}, {0x9EC3, 201}, {0x9ECD, 202}, {0x9ED1, 203}, {0x9ED9, 86},
+	{0x9EDA, 203}, {0x9EF9, 204}, {0x9EFD, 205}, {0x9F0E, 206}, {0x9F13, 207}, {0x9F20, 208},
+	{0x9F3B, 209}, {0x9F4A, 210}, {0x9F52, 211}, {0x9F8D, 212}, {0x9F90, 53}, {0x9F91, 212},
+	{0x9F9C, 213}, {0x9FA0, 214}, {0x9FA6, 86}, {0x9FA7, 72}, {0x9FA8, 22}, {0x9FA9, 140},
+	{0x9FAA, 156}, {0x9FAB, 159}, {0x9FAC, 167}, {0x9FAD, 187}, {0x9FAF, 167}, {0x9FB0, 77},
+	{0x9FB1, 31}, {0x9FB2, 167}, {0x9FB3, 32}, {0x9FB4, 5}, {0x9FB5, 64}, {0x9FB6, 32},
+	{0x9FB7, 140}, {0x9FB8, 42}, {0x9FB9, 12}, {0x9FBA, 24}, {0x9FBB, 149}, {0x9FBC, 32},
+	{0x9FBD, 86}, {0x9FBE, 107}, {0x9FBF, 140}, {0x9FC1, 149}, {0x9FC2, 159}, {0x9FC3, 109},
+	{0x9FC4, 75}, {0x9FC5, 113}, {0x9FC7, 9}, {0x9FC8, 60}, {0x9FCA, 140}, {0x9FCB, 145},
+	{0x9FCC, 85}, {0x9FCD, 32}, {0x9FCE, 112}, {0x9FCF, 167}, {0x9FD0, 195}, {0x9FD1, 15},
+	{0x9FD2, 140}, {0x9FD4, 167}, {0x9FD5, 195}, {0x9FD6, 1}, {0x9FD8, 9}, {0x9FDB, 15},
+	{0x9FDC, 64}, {0x9FDD, 75}, {0x9FDE, 78}, {0x9FDF, 115}, {0x9FE1, 122}, {0x9FE2, 128},
+	{0x9FE5, 138}, {0x9FE6, 140}, {0x9FE7, 170}, {0x9FE8, 173}, {0x9FE9, 195}, {0x9FEA, 86},
+	{0x9FEB, 84}, {0x9FEC, 112}, {0x9FED, 167}, {0x9FEE, 53}, {0x9FEF, 119}, {0x9FF0, 85},
+	{0x9FF1, 96}, {0x9FF2, 151}, {0x9FF3, 195}, {0x9FFA, 196}, {0x9FFB, 208}, {0x9FFD, 30},
+	{0x9FFE, 32}, {0x9FFF, 85}, {0xA000, 0}, {0xF900, 151}, {0xF901, 73}, {0xF902, 159},
+	{0xF903, 154}, {0xF904, 85}, {0xF905, 2}, {0xF906, 30}, {0xF907, 213}, {0xF909, 37},
+	{0xF90A, 167}, {0xF90B, 30}, {0xF90C, 37}, {0xF90D, 61}, {0xF90E, 104}, {0xF90F, 122},
+	{0xF910, 140}, {0xF911, 142}, {0xF912, 145}, {0xF913, 162}, {0xF914, 75}, {0xF915, 85},
+	{0xF916, 86}, {0xF917, 96}, {0xF918, 140}, {0xF919, 164}, {0xF91A, 187}, {0xF91B, 5},
+	{0xF91C, 26}, {0xF91D, 75}, {0xF91E, 86}, {0xF91F, 140}, {0xF920, 196}, {0xF921, 46},
+	{0xF922, 85}, {0xF923, 140}, {0xF924, 145}, {0xF925, 64}, {0xF926, 130}, {0xF927, 142},
+	{0xF928, 53}, {0xF929, 74}, {0xF92A, 85}, {0xF92B, 94}, {0xF92C, 163}, {0xF92D, 9},
+	{0xF92E, 15}, {0xF92F, 19}, {0xF930, 64}, {0xF931, 75}, {0xF932, 86}, {0xF933, 108},
+	{0xF934, 125}, {0xF935, 140}, {0xF936, 141}, {0xF937, 157}, {0xF938, 173}, {0xF939, 195},
+	{0xF93A, 196}, {0xF93B, 112}, {0xF93C, 113}, {0xF93D, 120}, {0xF93E, 140}, {0xF93F, 167},
+	{0xF940, 198}, {0xF941, 149}, {0xF942, 32}, {0xF943, 55}, {0xF944, 118}, {0xF945, 128},
+	{0xF946, 93}, {0xF947, 112}, {0xF948, 154}, {0xF949, 173}, {0xF94A, 32}, {0xF94B, 44},
+	{0xF94C, 75}, {0xF94D, 85}, {0xF94F, 120}, {0xF951, 170}, {0xF952, 19}, {0xF953, 130},
+	{0xF954, 15}, {0xF956, 115}, {0xF957, 120}, {0xF958, 140}, {0xF959, 170}, {0xF95A, 149},
+	{0xF95B, 64}, {0xF95C, 75}, {0xF95D, 149}, {0xF95E, 3}, {0xF95F, 40}, {0xF960, 61},
+	{0xF961, 95}, {0xF962, 102}, {0xF963, 21}, {0xF964, 112}, {0xF965, 9}, {0xF966, 60},
+	{0xF967, 1}, {0xF968, 85}, {0xF969, 66}, {0xF96A, 120}, {0xF96B, 28}, {0xF96C, 32},
+	{0xF96D, 109}, {0xF96E, 140}, {0xF96F, 149}, {0xF970, 79}, {0xF971, 161}, {0xF972, 85},
+	{0xF973, 64}, {0xF974, 140}, {0xF975, 64}, {0xF976, 102}, {0xF977, 8}, {0xF978, 11},
+	{0xF979, 15}, {0xF97A, 75}, {0xF97B, 119}, {0xF97C, 138}, {0xF97D, 149}, {0xF97E, 166},
+	{0xF97F, 19}, {0xF980, 30}, {0xF981, 38}, {0xF982, 53}, {0xF983, 70}, {0xF984, 85},
+	{0xF985, 112}, {0xF986, 169}, {0xF987, 187}, {0xF988, 198}, {0xF989, 202}, {0xF98A, 19},
+	{0xF98B, 72}, {0xF98C, 77}, {0xF98D, 159}, {0xF98E, 51}, {0xF98F, 61}, {0xF991, 64},
+	{0xF992, 85}, {0xF993, 86}, {0xF994, 96}, {0xF995, 115}, {0xF996, 120}, {0xF997, 128},
+	{0xF998, 159}, {0xF999, 140}, {0xF99A, 162}, {0xF99B, 167}, {0xF99C, 18}, {0xF99D, 19},
+	{0xF99E, 30}, {0xF99F, 86}, {0xF9A0, 145}, {0xF9A1, 149}, {0xF9A2, 53}, {0xF9A3, 61},
+	{0xF9A4, 64}, {0xF9A5, 78}, {0xF9A6, 118}, {0xF9A7, 94}, {0xF9A8, 9}, {0xF9A9, 31},
+	{0xF9AA, 40}, {0xF9AB, 46}, {0xF9AC, 61}, {0xF9AD, 96}, {0xF9AF, 123}, {0xF9B0, 128},
+	{0xF9B1, 167}, {0xF9B2, 173}, {0xF9B4, 181}, {0xF9B5, 9}, {0xF9B6, 113}, {0xF9B7, 164},
+	{0xF9B8, 171}, {0xF9B9, 61}, {0xF9BA, 6}, {0xF9BB, 9}, {0xF9BC, 40}, {0xF9BD, 44},
+	{0xF9BE, 68}, {0xF9BF, 75}, {0xF9C0, 86}, {0xF9C1, 104}, {0xF9C2, 140}, {0xF9C3, 162},
+	{0xF9C4, 212}, {0xF9C5, 72}, {0xF9C6, 170}, {0xF9C7, 18}, {0xF9C8, 75}, {0xF9CA, 85},
+	{0xF9CC, 96}, {0xF9CD, 102}, {0xF9CE, 112}, {0xF9CF, 120}, {0xF9D0, 181}, {0xF9D1, 12},
+	{0xF9D2, 62}, {0xF9D3, 170}, {0xF9D4, 9}, {0xF9D5, 46}, {0xF9D6, 85}, {0xF9D7, 159},
+	{0xF9D8, 60}, {0xF9D9, 61}, {0xF9DA, 75}, {0xF9DB, 95}, {0xF9DC, 170}, {0xF9DD, 18},
+	{0xF9DE, 30}, {0xF9DF, 44}, {0xF9E0, 72}, {0xF9E1, 75}, {0xF9E3, 85}, {0xF9E4, 96},
+	{0xF9E5, 104}, {0xF9E6, 122}, {0xF9E7, 145}, {0xF9E9, 166}, {0xF9EA, 172}, {0xF9EB, 23},
+	{0xF9EC, 85}, {0xF9ED, 30}, {0xF9EE, 86}, {0xF9EF, 96}, {0xF9F0, 140}, {0xF9F1, 170},
+	{0xF9F2, 195}, {0xF9F3, 198}, {0xF9F4, 75}, {0xF9F5, 85}, {0xF9F6, 131}, {0xF9F7, 117},
+	{0xF9F8, 118}, {0xF9F9, 119}, {0xF9FA, 94}, {0xF9FB, 86}, {0xF9FC, 149}, {0xF9FD, 9},
+	{0xF9FE, 140}, {0xF9FF, 18}, {0xFA01, 53}, {0xFA02, 64}, {0xFA03, 119}, {0xFA04, 40},
+	{0xFA05, 85}, {0xFA06, 72}, {0xFA07, 159}, {0xFA08, 144}, {0xFA09, 170}, {0xFA0A, 147},
+	{0xFA0B, 53}, {0xFA0C, 10}, {0xFA0D, 30}, {0xFA0E, 29}, {0xFA0F, 32}, {0xFA11, 46},
+	{0xFA12, 72}, {0xFA13, 75}, {0xFA15, 15}, {0xFA16, 94}, {0xFA17, 108}, {0xFA18, 113},
+	{0xFA1C, 174}, {0xFA1D, 119}, {0xFA1E, 124}, {0xFA1F, 140}, {0xFA21, 142}, {0xFA22, 149},
+	{0xFA23, 156}, {0xFA24, 162}, {0xFA26, 163}, {0xFA27, 167}, {0xFA29, 170}, {0xFA2A, 184},
+	{0xFA2D, 196}, {0xFA2E, 163}, {0xFA2F, 171}, {0xFA30, 9}, {0xFA32, 10}, {0xFA33, 19},
+	{0xFA35, 24}, {0xFA36, 30}, {0xFA39, 32}, {0xFA3A, 203}, {0xFA3B, 44}, {0xFA3C, 45},
+	{0xFA3D, 61}, {0xFA41, 66}, {0xFA42, 71}, {0xFA43, 72}, {0xFA44, 75}, {0xFA45, 85},
+	{0xFA48, 86}, {0xFA49, 87}, {0xFA4A, 96}, {0xFA4B, 112}, {0xFA4C, 113}, {0xFA54, 115},
+	{0xFA55, 116}, {0xFA56, 118}, {0xFA57, 120}, {0xFA5A, 122}, {0xFA5B, 125}, {0xFA5C, 132},
+	{0xFA5D, 140}, {0xFA60, 145}, {0xFA61, 113}, {0xFA62, 149}, {0xFA64, 154}, {0xFA66, 162},
+	{0xFA68, 172}, {0xFA69, 180}, {0xFA6A, 181}, {0xFA6B, 61}, {0xFA6C, 86}, {0xFA6D, 135},
+	{0xFA6E, 0}, {0xFA70, 1}, {0xFA71, 15}, {0xFA72, 11}, {0xFA73, 9}, {0xFA74, 10},
+	{0xFA75, 12}, {0xFA76, 19}, {0xFA77, 20}, {0xFA78, 30}, {0xFA7C, 32}, {0xFA7E, 37},
+	{0xFA80, 38}, {0xFA82, 53}, {0xFA84, 59}, {0xFA85, 60}, {0xFA86, 61}, {0xFA8C, 62},
+	{0xFA8D, 64}, {0xFA90, 66}, {0xFA91, 72}, {0xFA92, 74}, {0xFA94, 75}, {0xFA95, 78},
+	{0xFA96, 79}, {0xFA97, 85}, {0xFA9C, 86}, {0xFA9D, 109}, {0xFA9E, 87}, {0xFA9F, 94},
+	{0xFAA1, 96}, {0xFAA2, 98}, {0xFAA3, 102}, {0xFAA4, 104}, {0xFAA6, 108}, {0xFAA8, 109},
+	{0xFAAB, 112}, {0xFAAC, 116}, {0xFAAD, 118}, {0xFAAE, 119}, {0xFAAF, 120}, {0xFAB1, 121},
+	{0xFAB2, 125}, {0xFAB3, 140}, {0xFAB5, 142}, {0xFAB6, 145}, {0xFAB7, 146}, {0xFAB8, 113},
+	{0xFAB9, 149}, {0xFAC1, 154}, {0xFAC2, 159}, {0xFAC3, 162}, {0xFAC4, 164}, {0xFAC5, 167},
+	{0xFAC6, 170}, {0xFAC7, 172}, {0xFAC8, 174}, {0xFAC9, 178}, {0xFACA, 180}, {0xFACB, 181},
+	{0xFACD, 190}, {0xFACE, 213}, {0xFACF, 61}, {0xFAD1, 75}, {0xFAD3, 109}, {0xFAD6, 118},
+	{0xFAD7, 156}, {0xFAD8, 209}, {0xFAD9, 212}, {0xFADA, 0}, {0x20000, 1}, {0x2001B, 37},
+	{0x2001C, 1}, {0x2001D, 25}, {0x2001E, 1}, {0x20025, 7}, {0x20026, 1}, {0x20029, 6},
+	{0x2002A, 1}, {0x2002F, 31}, {0x20030, 1}, {0x20037, 30}, {0x20038, 1}, {0x20039, 30},
+	{0x2003A, 1}, {0x2003C, 35}, {0x2003D, 1}, {0x20049, 16}, {0x2004A, 1}, {0x2005C, 12},
+	{0x2005D, 1}, {0x2005E, 72}, {0x2005F, 1}, {0x20061, 2}, {0x20064, 31}, {0x20065, 2},
+	{0x20072, 170}, {0x20073, 2}, {0x20077, 30}, {0x20078, 2}, {0x2007C, 3}, {0x2007E, 5},
+	{0x2007F, 3}, {0x20086, 4}, {0x200A8, 137}, {0x200A9, 4}, {0x200AC, 27}, {0x200AD, 4},
+	{0x200AE, 27}, {0x200AF, 4}, {0x200B0, 27}, {0x200B1, 81}, {0x200B2, 4}, {0x200B5, 101},
+	{0x200B6, 4}, {0x200B8, 5}, {0x200B9, 4}, {0x200C9, 5}, {0x200EB, 102}, {0x200EC, 5},
+	{0x200F0, 101}, {0x200F1, 39}, {0x200F2, 5}, {0x2010C, 6}, {0x2011E, 7}, {0x20134, 57},
+	{0x20135, 7}, {0x20141, 8}, {0x20157, 36}, {0x20158, 8}, {0x20170, 111}, {0x20171, 36},
+	{0x20172, 8}, {0x20179, 52}, {0x2017A, 8}, {0x2017D, 42}, {0x2017E, 8}, {0x2018A, 30},
+	{0x2018B, 8}, {0x20193, 36}, {0x20194, 8}, {0x20195, 36}, {0x20196, 8}, {0x20199, 36},
+	{0x2019A, 8}, {0x2019B, 72}, {0x2019C, 210}, {0x2019D, 8}, {0x201A2, 9}, {0x201AE, 19},
+	{0x201AF, 9}, {0x201B1, 24}, {0x201B2, 53}, {0x201B3, 9}, {0x201D2, 11}, {0x201D3, 9},
+	{0x20202, 30}, {0x20203, 9}, {0x20224, 42}, {0x20225, 9}, {0x202D1, 184}, {0x202D2, 9},
+	{0x20306, 11}, {0x20307, 9}, {0x2031E, 35}, {0x2031F, 106}, {0x20320, 9}, {0x2032A, 109},
+	{0x2032B, 9}, {0x20362, 110}, {0x20363, 9}, {0x20398, 114}, {0x20399, 9}, {0x2039B, 89},
+	{0x2039C, 211}, {0x2039D, 9}, {0x203B6, 62}, {0x203B7, 9}, {0x203D3, 30}, {0x203D4, 9},
+	{0x203E7, 30}, {0x203E8, 9}, {0x2041C, 149}, {0x2041D, 9}, {0x20442, 89}, {0x20443, 9},
+	{0x20471, 73}, {0x20472, 9}, {0x20474, 142}, {0x20475, 9}, {0x20476, 10}, {0x204CC, 53},
+	{0x204CD, 10}, {0x204DB, 11}, {0x20500, 12}, {0x20507, 162}, {0x20508, 12}, {0x20518, 57},
+	{0x20519, 12}, {0x20532, 28}, {0x20533, 12}, {0x2053B, 134}, {0x2053C, 13}, {0x2056B, 61},
+	{0x2056C, 13}, {0x20573, 14}, {0x2059F, 113}, {0x205A0, 14}, {0x205AC, 15}, {0x20627, 16},
+	{0x20657, 47}, {0x20658, 16}, {0x2065A, 34}, {0x2065B, 16}, {0x2066C, 182}, {0x2066D, 16},
+	{0x20674, 17}, {0x2067C, 30}, {0x2067D, 17}, {0x20691, 176}, {0x20692, 17}, {0x2069B, 32},
+	{0x2069C, 17}, {0x206A3, 18}, {0x2079C, 115}, {0x2079D, 18}, {0x2080B, 154}, {0x2080C, 18},
+	{0x20832, 19}, {0x208CC, 20}, {0x2090E, 21}, {0x20922, 1}, {0x20923, 21}, {0x2092B, 134},
+	{0x2092C, 22}, {0x20932, 23}, {0x20933, 22}, {0x20943, 23}, {0x20944, 22}, {0x2096D, 23},
+	{0x2097B, 24}, {0x209D2, 25}, {0x209DD, 5}, {0x209DE, 25}, {0x209F8, 197}, {0x209F9, 25},
+	{0x20A03, 36}, {0x20A04, 25}, {0x20A0D, 26}, {0x20A2C, 27}, {0x20A56, 12}, {0x20A57, 27},
+	{0x20AB9, 98}, {0x20ABA, 27}, {0x20AC8, 82}, {0x20AC9, 27}, {0x20AD3, 28}, {0x20AEE, 16},
+	{0x20AEF, 28}, {0x20AF3, 29}, {0x20AF4, 28}, {0x20B1A, 29}, {0x20B49, 25}, {0x20B4A, 29},
+	{0x20B76, 35}, {0x20B77, 29}, {0x20B7B, 12}, {0x20B7C, 29}, {0x20B99, 30}, {0x20CEB, 101},
+	{0x20CEC, 30}, {0x20D26, 96}, {0x20D27, 30}, {0x20FA7, 114}, {0x20FA8, 30}, {0x21109, 89},
+	{0x2110A, 30}, {0x21155, 33}, {0x21156, 123}, {0x21157, 30}, {0x211A0, 31}, {0x211CD, 102},
+	{0x211CE, 31}, {0x2123C, 32}, {0x21257, 39}, {0x21258, 32}, {0x212DC, 130}, {0x212DD, 32},
+	{0x2147A, 168}, {0x2147B, 32}, {0x214A1, 207}, {0x214A2, 32}, {0x214DB, 150}, {0x214DC, 32},
+	{0x214F7, 184}, {0x214F8, 32}, {0x2151B, 33}, {0x21531, 37}, {0x21532, 33}, {0x21537, 207},
+	{0x21538, 33}, {0x21546, 151}, {0x21547, 33}, {0x2154E, 112}, {0x2154F, 33}, {0x21552, 34},
+	{0x2155E, 35}, {0x21584, 36}, {0x215D2, 37}, {0x215DC, 94}, {0x215DD, 37}, {0x216A6, 38},
+	{0x2188F, 119}, {0x21890, 38}, {0x218E2, 166}, {0x218E3, 38}, {0x2193C, 39}, {0x2194B, 25},
+	{0x2194C, 39}, {0x219B9, 40}, {0x21A9E, 29}, {0x21A9F, 40}, {0x21B1D, 41}, {0x21B54, 42},
+	{0x21BC1, 43}, {0x21BE5, 142}, {0x21BE6, 43}, {0x21C23, 44}, {0x21CFE, 45}, {0x21D2D, 46},
+	{0x21DA4, 101}, {0x21DA5, 46}, {0x21F97, 37}, {0x21F98, 46}, {0x21FE6, 47}, {0x22011, 48},
+	{0x22033, 49}, {0x22052, 50}, {0x22189, 51}, {0x221A1, 5}, {0x221A2, 51}, {0x221A3, 36},
+	{0x221A4, 51}, {0x221AF, 52}, {0x221C0, 44}, {0x221C1, 52}, {0x221D4, 42}, {0x221D5, 17},
+	{0x221D6, 52}, {0x221D7, 53}, {0x22232, 39}, {0x22233, 53}, {0x22256, 198}, {0x22257, 53},
+	{0x222DC, 142}, {0x222DD, 53}, {0x22307, 85}, {0x22308, 53}, {0x22317, 54}, {0x2232C, 55},
+	{0x2236D, 17}, {0x2236E, 55}, {0x2237A, 56}, {0x22397, 57}, {0x2242B, 119}, {0x2242C, 57},
+	{0x2244F, 58}, {0x22456, 102}, {0x22457, 58}, {0x22470, 48}, {0x22471, 58}, {0x22480, 59},
+	{0x224BC, 60}, {0x2252C, 144}, {0x2252D, 60}, {0x22585, 144}, {0x22586, 60}, {0x2258B, 144},
+	{0x2258C, 60}, {0x2259B, 203}, {0x2259C, 60}, {0x225A1, 144}, {0x225A2, 60}, {0x225A9, 61},
+	{0x2298C, 62}, {0x22A10, 30}, {0x22A11, 62}, {0x22A24, 63}, {0x22A65, 64}, {0x22C3F, 109},
+	{0x22C40, 64}, {0x22EB5, 65}, {0x22EC8, 36}, {0x22EC9, 65}, {0x22EEB, 66}, {0x22FA5, 109},
+	{0x22FA6, 66}, {0x22FC6, 113}, {0x22FC7, 66}, {0x23041, 67}, {0x2304B, 66}, {0x2304C, 67},
+	{0x2305B, 66}, {0x2305C, 67}, {0x2306C, 68}, {0x23091, 69}, {0x230AB, 113}, {0x230AC, 69},
+	{0x230D7, 70}, {0x2312D, 71}, {0x2313B, 72}, {0x23189, 73}, {0x2318A, 72}, {0x23261, 54},
+	{0x23262, 72}, {0x23292, 41}, {0x23293, 72}, {0x2331A, 149}, {0x2331B, 72}, {0x2331E, 123},
+	{0x2331F, 72}, {0x23321, 73}, {0x2335D, 74}, {0x23368, 130}, {0x23369, 74}, {0x2336F, 130},
+	{0x23371, 74}, {0x233B3, 75}, {0x23467, 32}, {0x23468, 75}, {0x23722, 114}, {0x23723, 75},
+	{0x2378F, 112}, {0x23790, 75}, {0x23873, 36}, {0x23874, 75}, {0x23880, 76}, {0x238EC, 154},
+	{0x238ED, 76}, {0x23942, 77}, {0x23965, 24}, {0x23966, 77}, {0x23972, 59}, {0x23973, 77},
+	{0x2398B, 211}, {0x2398C, 77}, {0x2398F, 56}, {0x23990, 77}, {0x239AD, 46}, {0x239AE, 77},
+	{0x239B5, 78}, {0x23A82, 79}, {0x23AAD, 109}, {0x23AAE, 79}, {0x23AEC, 80}, {0x23B02, 81},
+	{0x23B1B, 82}, {0x23C45, 83}, {0x23C55, 84}, {0x23C71, 85}, {0x23F19, 58}, {0x23F1A, 85},
+	{0x24022, 48}, {0x24023, 85}, {0x24182, 86}, {0x242F3, 195}, {0x242F4, 86}, {0x24380, 140},
+	{0x24381, 86}, {0x24382, 176}, {0x24383, 86}, {0x243E0, 37}, {0x243E1, 86}, {0x24443, 130},
+	{0x24444, 86}, {0x244CF, 130}, {0x244D0, 86}, {0x244DF, 134}, {0x244E0, 86}, {0x244EF, 87},
+	{0x24517, 31}, {0x24518, 87}, {0x24541, 121}, {0x24542, 87}, {0x2454E, 88}, {0x2455C, 89},
+	{0x2456A, 90}, {0x245A8, 91}, {0x24605, 92}, {0x24614, 93}, {0x2469C, 77}, {0x2469D, 93},
+	{0x246D7, 79}, {0x246D8, 93}, {0x2471A, 94}, {0x247F5, 63}, {0x247F6, 94}, {0x248E5, 95},
+	{0x248E9, 96}, {0x24AE1, 121}, {0x24AE2, 96}, {0x24AEA, 97}, {0x24B26, 98}, {0x24BBA, 99},
+	{0x24BCF, 42}, {0x24BD0, 99}, {0x24BD3, 100}, {0x24BFD, 57}, {0x24BFE, 100}, {0x24C03, 101},
+	{0x24C12, 102}, {0x24C61, 5}, {0x24C62, 102}, {0x24CCF, 1}, {0x24CD0, 102}, {0x24CDB, 165},
+	{0x24CDC, 102}, {0x24D13, 103}, {0x24D25, 104}, {0x24F25, 105}, {0x24F3D, 106}, {0x24FC6, 107},
+	{0x2503B, 207}, {0x2503F, 108}, {0x250B3, 15}, {0x250B4, 108}, {0x250E4, 109}, {0x251F4, 69},
+	{0x251F5, 109}, {0x25315, 177}, {0x25316, 109}, {0x25344, 147}, {0x25345, 109}, {0x2535D, 110},
+	{0x253A6, 111}, {0x25415, 112}, {0x255F6, 186}, {0x255F7, 112}, {0x25605, 113}, {0x2573B, 114},
+	{0x25740, 5}, {0x25741, 114}, {0x2574C, 115}, {0x258F7, 166}, {0x258F8, 115}, {0x25914, 75},
+	{0x25915, 115}, {0x25922, 116}, {0x259E2, 128}, {0x259E3, 116}, {0x25A55, 117}, {0x25AC2, 72},
+	{0x25AC3, 117}, {0x25AD7, 118}, {0x25E25, 119}, {0x25F85, 120}, {0x26222, 121}, {0x2626A, 122},
+	{0x262A4, 46}, {0x262A5, 122}, {0x262EA, 54}, {0x262EB, 122}, {0x26329, 123}, {0x2632A, 122},
+	{0x2634B, 123}, {0x26385, 28}, {0x26386, 123}, {0x263F2, 124}, {0x26451, 61}, {0x26452, 124},
+	{0x264B1, 125}, {0x264CE, 126}, {0x264E4, 127}, {0x2652E, 128}, {0x26612, 129}, {0x26629, 130},
+	{0x26657, 74}, {0x26658, 130}, {0x266B9, 74}, {0x266BA, 130}, {0x26733, 74}, {0x26734, 130},
+	{0x26856, 74}, {0x26857, 61}, {0x26858, 130}, {0x268DD, 131}, {0x268F9, 132}, {0x26933, 133},
+	{0x26948, 32}, {0x26949, 133}, {0x26951, 134}, {0x26994, 114}, {0x26995, 134}, {0x269A8, 86},
+	{0x269A9, 134}, {0x269C6, 135}, {0x269FE, 136}, {0x26A07, 137}, {0x26AB9, 108}, {0x26ABA, 137},
+	{0x26ACB, 138}, {0x26AD3, 139}, {0x26AF3, 140}, {0x26D36, 85}, {0x26D37, 140}, {0x26EC7, 61},
+	{0x26EC8, 140}, {0x27186, 113}, {0x27187, 140}, {0x2719B, 141}, {0x27210, 184}, {0x27211, 141},
+	{0x2721D, 142}, {0x27430, 122}, {0x27431, 142}, {0x275A0, 196}, {0x275A1, 142}, {0x275A7, 143},
+	{0x275DD, 144}, {0x27607, 145}, {0x277E0, 146}, {0x27806, 147}, {0x278B2, 148}, {0x2795B, 149},
+	{0x27BA5, 30}, {0x27BA6, 149}, {0x27BAB, 150}, {0x27BDA, 151}, {0x27BE7, 32}, {0x27BE8, 151},
+	{0x27C26, 152}, {0x27CA0, 153}, {0x27D24, 154}, {0x27DC5, 122}, {0x27DC6, 154}, {0x27E58, 155},
+	{0x27E86, 156}, {0x27FB7, 157}, {0x2820F, 158}, {0x282A0, 159}, {0x2840B, 160}, {0x28433, 63},
+	{0x28434, 102}, {0x28435, 160}, {0x28443, 161}, {0x2844D, 162}, {0x285D3, 30}, {0x285D4, 162},
+	{0x2863C, 159}, {0x2863D, 162}, {0x28668, 163}, {0x287F0, 164}, {0x2890F, 165}, {0x28922, 166},
+	{0x2893D, 167}, {0x2899E, 137}, {0x2899F, 167}, {0x28C57, 168}, {0x28CC7, 169}, {0x28E0F, 170},
+	{0x28E74, 39}, {0x28E75, 170}, {0x28F76, 171}, {0x28F85, 172}, {0x29076, 173}, {0x2912E, 162},
+	{0x2912F, 173}, {0x291D5, 174}, {0x291E6, 175}, {0x29203, 176}, {0x2925B, 177}, {0x2937B, 193},
+	{0x2937C, 177}, {0x29392, 178}, {0x29401, 179}, {0x29417, 180}, {0x2944B, 181}, {0x29594, 196},
+	{0x29595, 181}, {0x29598, 182}, {0x295B0, 67}, {0x295B1, 182}, {0x29671, 183}, {0x2967F, 184},
+	{0x29810, 185}, {0x2982E, 66}, {0x2982F, 185}, {0x29830, 66}, {0x29831, 185}, {0x2983A, 186},
+	{0x29867, 187}, {0x29A11, 188}, {0x29ABF, 189}, {0x29AF4, 190}, {0x29C0A, 191}, {0x29C20, 192},
+	{0x29C2B, 193}, {0x29C79, 194}, {0x29D4B, 195}, {0x29F8F, 196}, {0x2A1DE, 207}, {0x2A1DF, 196},
+	{0x2A256, 197}, {0x2A28B, 198}, {0x2A2FC, 199}, {0x2A31B, 34}, {0x2A31C, 199}, {0x2A391, 200},
+	{0x2A3B3, 201}, {0x2A3ED, 202}, {0x2A417, 203}, {0x2A4CB, 204}, {0x2A4D1, 205}, {0x2A4FB, 213},
+	{0x2A4FC, 205}, {0x2A502, 206}, {0x2A50B, 207}, {0x2A538, 208}, {0x2A57F, 209}, {0x2A580, 208},
+	{0x2A590, 209}, {0x2A5C4, 210}, {0x2A5D4, 211}, {0x2A691, 212}, {0x2A6A6, 213}, {0x2A6CA, 214},
+	{0x2A6D7, 30}, {0x2A6D8, 31}, {0x2A6D9, 1}, {0x2A6DB, 44}, {0x2A6DC, 48}, {0x2A6DD, 16},
+	{0x2A6DE, 96}, {0x2A6DF, 109}, {0x2A6E0, 0}, {0x2A700, 1}, {0x2A708, 2}, {0x2A70A, 3},
+	{0x2A70C, 4}, {0x2A710, 5}, {0x2A71C, 6}, {0x2A71E, 7}, {0x2A720, 8}, {0x2A727, 9},
+	{0x2A77F, 10}, {0x2A787, 11}, {0x2A788, 12}, {0x2A78E, 13}, {0x2A78F, 14}, {0x2A796, 15},
+	{0x2A7B1, 16}, {0x2A7B6, 17}, {0x2A7BE, 18}, {0x2A7D7, 19}, {0x2A7E8, 21}, {0x2A7EC, 22},
+	{0x2A7F3, 24}, {0x2A7FD, 25}, {0x2A801, 26}, {0x2A803, 27}, {0x2A81C, 28}, {0x2A823, 29},
+	{0x2A832, 30}, {0x2A8A8, 31}, {0x2A8B1, 32}, {0x2A932, 33}, {0x2A935, 34}, {0x2A936, 35},
+	{0x2A937, 36}, {0x2A941, 37}, {0x2A964, 38}, {0x2A9B6, 39}, {0x2A9C5, 40}, {0x2A9F7, 41},
+	{0x2A9FF, 42}, {0x2AA07, 43}, {0x2AA09, 44}, {0x2AA22, 46}, {0x2AA61, 47}, {0x2AA63, 48},
+	{0x2AA6B, 49}, {0x2AA72, 50}, {0x2AA81, 51}, {0x2AA8A, 52}, {0x2AA8C, 53}, {0x2AAAC, 54},
+	{0x2AAB3, 55}, {0x2AABA, 57}, {0x2AAC6, 58}, {0x2AAC8, 59}, {0x2AACB, 60}, {0x2AADD, 61},
+	{0x2AB49, 62}, {0x2AB58, 63}, {0x2AB5C, 64}, {0x2ABC5, 65}, {0x2ABC8, 66}, {0x2ABE0, 67},
+	{0x2ABEB, 68}, {0x2ABF1, 69}, {0x2ABF2, 70}, {0x2AC06, 72}, {0x2AC55, 73}, {0x2AC59, 74},
+	{0x2AC71, 75}, {0x2AD29, 76}, {0x2AD35, 77}, {0x2AD40, 78}, {0x2AD48, 79}, {0x2AD4A, 66},
+	{0x2AD4B, 79}, {0x2AD54, 80}, {0x2AD55, 81}, {0x2AD56, 82}, {0x2AD63, 84}, {0x2AD68, 85},
+	{0x2AE0D, 86}, {0x2AE8D, 87}, {0x2AE9B, 88}, {0x2AE9D, 89}, {0x2AE9E, 90}, {0x2AEA2, 91},
+	{0x2AEA7, 92}, {0x2AEA9, 93}, {0x2AEB7, 94}, {0x2AECD, 96}, {0x2AF33, 97}, {0x2AF36, 98},
+	{0x2AF40, 99}, {0x2AF41, 100}, {0x2AF46, 101}, {0x2AF47, 102}, {0x2AF67, 103}, {0x2AF68, 104},
+	{0x2AF7B, 106}, {0x2AF86, 107}, {0x2AF8A, 108}, {0x2AF9F, 109}, {0x2AFC6, 110}, {0x2AFC8, 111},
+	{0x2AFD1, 112}, {0x2B000, 113}, {0x2B025, 114}, {0x2B026, 115}, {0x2B04A, 116}, {0x2B05E, 117},
+	{0x2B070, 118}, {0x2B0B1, 119}, {0x2B0DA, 120}, {0x2B13A, 121}, {0x2B140, 122}, {0x2B14E, 123},
+	{0x2B162, 124}, {0x2B173, 125}, {0x2B179, 127}, {0x2B180, 128}, {0x2B194, 129}, {0x2B196, 130},
+	{0x2B1C3, 74}, {0x2B1C4, 130}, {0x2B1C5, 131}, {0x2B1CA, 132}, {0x2B1CE, 133}, {0x2B1D2, 134},
+	{0x2B1D4, 135}, {0x2B1DA, 137}, {0x2B1E4, 139}, {0x2B1E5, 140}, {0x2B29D, 141}, {0x2B2A4, 142},
+	{0x2B2EA, 143}, {0x2B2ED, 144}, {0x2B2F2, 145}, {0x2B31A, 146}, {0x2B31C, 147}, {0x2B32E, 148},
+	{0x2B332, 149}, {0x2B380, 150}, {0x2B383, 151}, {0x2B385, 152}, {0x2B38A, 153}, {0x2B38E, 154},
+	{0x2B3AD, 155}, {0x2B3B1, 156}, {0x2B3C0, 157}, {0x2B3EA, 158}, {0x2B3F2, 159}, {0x2B41A, 160},
+	{0x2B41E, 162}, {0x2B457, 163}, {0x2B473, 164}, {0x2B480, 165}, {0x2B481, 166}, {0x2B486, 167},
+	{0x2B516, 168}, {0x2B518, 169}, {0x2B53A, 170}, {0x2B559, 171}, {0x2B55A, 172}, {0x2B55D, 173},
+	{0x2B578, 174}, {0x2B57D, 175}, {0x2B580, 176}, {0x2B585, 177}, {0x2B58C, 178}, {0x2B597, 180},
+	{0x2B59D, 181}, {0x2B5BB, 182}, {0x2B5CC, 183}, {0x2B5CD, 184}, {0x2B5F6, 185}, {0x2B5FC, 186},
+	{0x2B605, 187}, {0x2B632, 188}, {0x2B635, 189}, {0x2B638, 190}, {0x2B644, 193}, {0x2B648, 194},
+	{0x2B64F, 195}, {0x2B6AE, 196}, {0x2B707, 197}, {0x2B70B, 198}, {0x2B710, 199}, {0x2B716, 200},
+	{0x2B718, 201}, {0x2B719, 203}, {0x2B71C, 204}, {0x2B71D, 205}, {0x2B720, 206}, {0x2B722, 208},
+	{0x2B724, 209}, {0x2B725, 211}, {0x2B731, 212}, {0x2B733, 213}, {0x2B734, 214}, {0x2B735, 4},
+	{0x2B736, 130}, {0x2B737, 163}, {0x2B738, 30}, {0x2B739, 58}, {0x2B73A, 0}, {0x2B740, 1},
+	{0x2B744, 4}, {0x2B745, 8}, {0x2B746, 9}, {0x2B74C, 10}, {0x2B74D, 13}, {0x2B74E, 15},
+	{0x2B750, 18}, {0x2B751, 19}, {0x2B752, 24}, {0x2B755, 27}, {0x2B758, 30}, {0x2B75F, 32},
+	{0x2B762, 36}, {0x2B764, 37}, {0x2B766, 38}, {0x2B76F, 39}, {0x2B770, 40}, {0x2B772, 44},
+	{0x2B773, 46}, {0x2B776, 53}, {0x2B778, 59}, {0x2B779, 61}, {0x2B77A, 64}, {0x2B780, 70},
+	{0x2B782, 72}, {0x2B785, 74}, {0x2B788, 75}, {0x2B793, 77}, {0x2B794, 78}, {0x2B795, 83},
+	{0x2B797, 85}, {0x2B79F, 86}, {0x2B7A2, 93}, {0x2B7A3, 94}, {0x2B7A5, 96}, {0x2B7AA, 99},
+	{0x2B7AB, 102}, {0x2B7AC, 104}, {0x2B7AE, 106}, {0x2B7AF, 108}, {0x2B7B2, 109}, {0x2B7B4, 113},
+	{0x2B7B7, 115}, {0x2B7B9, 116}, {0x2B7BB, 117}, {0x2B7BD, 118}, {0x2B7C0, 119}, {0x2B7C1, 120},
+	{0x2B7C8, 123}, {0x2B7C9, 128}, {0x2B7CA, 130}, {0x2B7CB, 134}, {0x2B7CC, 140}, {0x2B7D7, 142},
+	{0x2B7D8, 144}, {0x2B7DA, 145}, {0x2B7DB, 146}, {0x2B7DC, 147}, {0x2B7DD, 149}, {0x2B7E3, 157},
+	{0x2B7E4, 159}, {0x2B7E7, 162}, {0x2B7EB, 163}, {0x2B7EE, 164}, {0x2B7EF, 166}, {0x2B7F0, 167},
+	{0x2B802, 169}, {0x2B803, 170}, {0x2B804, 178}, {0x2B806, 181}, {0x2B807, 182}, {0x2B809, 187},
+	{0x2B80D, 195}, {0x2B813, 196}, {0x2B817, 197}, {0x2B818, 208}, {0x2B819, 211}, {0x2B81D, 213},
+	{0x2B81E, 0}, {0x2B820, 56}, {0x2B821, 1}, {0x2B843, 2}, {0x2B84F, 4}, {0x2B862, 5},
+	{0x2B871, 7}, {0x2B87A, 8}, {0x2B885, 9}, {0x2B8D9, 75}, {0x2B8DB, 9}, {0x2B917, 10},
+	{0x2B929, 11}, {0x2B92B, 12}, {0x2B937, 13}, {0x2B938, 14}, {0x2B93E, 15}, {0x2B95E, 16},
+	{0x2B964, 17}, {0x2B970, 18}, {0x2B9A4, 19}, {0x2B9C0, 20}, {0x2B9C7, 21}, {0x2B9CB, 22},
+	{0x2B9DE, 24}, {0x2B9EE, 25}, {0x2B9F9, 26}, {0x2BA02, 27}, {0x2BA26, 28}, {0x2BA32, 29},
+	{0x2BA4F, 30}, {0x2BB42, 31}, {0x2BB56, 32}, {0x2BBC1, 33}, {0x2BBCA, 34}, {0x2BBCB, 35},
+	{0x2BBCD, 36}, {0x2BBDB, 37}, {0x2BC06, 38}, {0x2BCA1, 39}, {0x2BCB5, 40}, {0x2BD2C, 41},
+	{0x2BD38, 42}, {0x2BD4E, 43}, {0x2BD53, 44}, {0x2BD6E, 45}, {0x2BD71, 46}, {0x2BDA7, 47},
+	{0x2BDAB, 48}, {0x2BDB5, 49}, {0x2BDBD, 50}, {0x2BDD4, 51}, {0x2BDDF, 52}, {0x2BDE5, 53},
+	{0x2BE11, 54}, {0x2BE16, 55}, {0x2BE25, 57}, {0x2BE44, 58}, {0x2BE49, 59}, {0x2BE4B, 60},
+	{0x2BE6B, 61}, {0x2BEE6, 62}, {0x2BF09, 63}, {0x2BF13, 64}, {0x2BFA3, 65}, {0x2BFA6, 66},
+	{0x2BFED, 67}, {0x2BFF3, 68}, {0x2BFF9, 69}, {0x2C000, 70}, {0x2C025, 71}, {0x2C026, 72},
+	{0x2C05F, 73}, {0x2C070, 74}, {0x2C09B, 75}, {0x2C15D, 76}, {0x2C176, 77}, {0x2C191, 78},
+	{0x2C19E, 79}, {0x2C1B6, 80}, {0x2C1B9, 81}, {0x2C1BC, 82}, {0x2C1C9, 83}, {0x2C1CF, 84},
+	{0x2C1D4, 85}, {0x2C274, 86}, {0x2C2E4, 87}, {0x2C2FB, 88}, {0x2C2FD, 89}, {0x2C2FF, 90},
+	{0x2C313, 91}, {0x2C317, 92}, {0x2C319, 93}, {0x2C329, 94}, {0x2C34F, 95}, {0x2C350, 96},
+	{0x2C3A2, 97}, {0x2C3A4, 98}, {0x2C3B1, 99}, {0x2C3B3, 100}, {0x2C3BD, 101}, {0x2C3BF, 102},
+	{0x2C3D9, 103}, {0x2C3DA, 104}, {0x2C402, 105}, {0x2C403, 106}, {0x2C412, 107}, {0x2C417, 108},
+	{0x2C445, 109}, {0x2C46D, 110}, {0x2C470, 111}, {0x2C479, 112}, {0x2C4AC, 113}, {0x2C4DE, 114},
+	{0x2C4E0, 115}, {0x2C4F8, 202}, {0x2C4F9, 115}, {0x2C505, 116}, {0x2C516, 117}, {0x2C52C, 118},
+	{0x2C58B, 119}, {0x2C5C3, 120}, {0x2C64C, 121}, {0x2C655, 122}, {0x2C66C, 123}, {0x2C683, 124},
+	{0x2C689, 125}, {0x2C68F, 126}, {0x2C690, 127}, {0x2C692, 128}, {0x2C6AA, 129}, {0x2C6AF, 130},
+	{0x2C6E2, 131}, {0x2C6EC, 132}, {0x2C6F1, 133}, {0x2C6F8, 134}, {0x2C705, 135}, {0x2C711, 137},
+	{0x2C71D, 139}, {0x2C720, 140}, {0x2C7E7, 141}, {0x2C7F4, 142}, {0x2C83C, 143}, {0x2C83D, 144},
+	{0x2C842, 145}, {0x2C878, 146}, {0x2C87C, 147}, {0x2C895, 148}, {0x2C89A, 149}, {0x2C932, 150},
+	{0x2C936, 151}, {0x2C93B, 152}, {0x2C948, 153}, {0x2C94E, 154}, {0x2C981, 155}, {0x2C985, 156},
+	{0x2C9A0, 157}, {0x2C9E0, 158}, {0x2C9F1, 159}, {0x2CA16, 160}, {0x2CA1C, 162}, {0x2CA73, 163},
+	{0x2CAA6, 164}, {0x2CABA, 165}, {0x2CABC, 166}, {0x2CAC2, 167}, {0x2CB84, 168}, {0x2CB85, 169},
+	{0x2CBBA, 170}, {0x2CBEA, 172}, {0x2CBF8, 173}, {0x2CC17, 174}, {0x2CC19, 175}, {0x2CC20, 176},
+	{0x2CC24, 177}, {0x2CC2A, 178}, {0x2CC39, 180}, {0x2CC3F, 181}, {0x2CC74, 182}, {0x2CC89, 183},
+	{0x2CC8B, 184}, {0x2CCD5, 185}, {0x2CCDC, 186}, {0x2CCE8, 187}, {0x2CD11, 188}, {0x2CD14, 189},
+	{0x2CD27, 190}, {0x2CD30, 191}, {0x2CD32, 193}, {0x2CD3D, 194}, {0x2CD41, 195}, {0x2CDBC, 196},
+	{0x2CE32, 197}, {0x2CE3A, 198}, {0x2CE42, 199}, {0x2CE4F, 200}, {0x2CE50, 201}, {0x2CE54, 202},
+	{0x2CE55, 203}, {0x2CE5C, 204}, {0x2CE5D, 205}, {0x2CE65, 206}, {0x2CE6D, 208}, {0x2CE6F, 209},
+	{0x2CE71, 210}, {0x2CE74, 211}, {0x2CE97, 212}, {0x2CE9E, 213}, {0x2CE9F, 214}, {0x2CEA2, 0},
+	{0x2CEB0, 1}, {0x2CEF2, 2}, {0x2CEFA, 3}, {0x2CEFF, 4}, {0x2CF16, 5}, {0x2CF36, 6},
+	{0x2CF3D, 7}, {0x2CF43, 8}, {0x2CF61, 9}, {0x2D016, 10}, {0x2D03B, 11}, {0x2D044, 12},
+	{0x2D05F, 13}, {0x2D073, 14}, {0x2D086, 15}, {0x2D0A9, 16}, {0x2D0BB, 17}, {0x2D0C2, 18},
+	{0x2D11E, 19}, {0x2D144, 20}, {0x2D150, 21}, {0x2D154, 22}, {0x2D15F, 23}, {0x2D160, 24},
+	{0x2D16F, 25}, {0x2D172, 26}, {0x2D180, 27}, {0x2D199, 28}, {0x2D1A5, 29}, {0x2D1B8, 30},
+	{0x2D35A, 31}, {0x2D37A, 32}, {0x2D412, 33}, {0x2D41D, 34}, {0x2D41F, 35}, {0x2D433, 36},
+	{0x2D442, 37}, {0x2D467, 38}, {0x2D4B6, 39}, {0x2D4DE, 40}, {0x2D529, 41}, {0x2D544, 42},
+	{0x2D54D, 43}, {0x2D554, 44}, {0x2D57E, 45}, {0x2D580, 46}, {0x2D5FA, 47}, {0x2D602, 48},
+	{0x2D60A, 49}, {0x2D611, 50}, {0x2D63E, 51}, {0x2D648, 52}, {0x2D64D, 53}, {0x2D692, 54},
+	{0x2D696, 55}, {0x2D69F, 56}, {0x2D6A5, 57}, {0x2D6CD, 58}, {0x2D6D3, 59}, {0x2D6DC, 60},
+	{0x2D70B, 61}, {0x2D7EE, 62}, {0x2D7FF, 63}, {0x2D80D, 64}, {0x2D8D4, 65}, {0x2D8E1, 66},
+	{0x2D914, 67}, {0x2D91C, 68}, {0x2D91F, 69}, {0x2D927, 70}, {0x2D94A, 71}, {0x2D94B, 72},
+	{0x2DA18, 73}, {0x2DA24, 74}, {0x2DA58, 75}, {0x2DB44, 76}, {0x2DB58, 77}, {0x2DB7D, 78},
+	{0x2DBA8, 79}, {0x2DBC7, 80}, {0x2DBCA, 81}, {0x2DBD2, 82}, {0x2DBF0, 83}, {0x2DBF2, 84},
+	{0x2DBF6, 85}, {0x2DCFF, 86}, {0x2DDAA, 87}, {0x2DDBA, 88}, {0x2DDBE, 90}, {0x2DDC9, 91},
+	{0x2DDCF, 92}, {0x2DDD3, 93}, {0x2DDF8, 94}, {0x2DE35, 96}, {0x2DE97, 97}, {0x2DE9B, 98},
+	{0x2DEAA, 99}, {0x2DEB1, 100}, {0x2DEB8, 101}, {0x2DEBD, 102}, {0x2DEFE, 103}, {0x2DF06, 104},
+	{0x2DF3D, 105}, {0x2DF46, 106}, {0x2DF63, 107}, {0x2DF7C, 108}, {0x2DF97, 109}, {0x2DFF5, 110},
+	{0x2DFFD, 111}, {0x2E00B, 112}, {0x2E05C, 113}, {0x2E0AC, 114}, {0x2E0AF, 115}, {0x2E0F8, 116},
+	{0x2E127, 117}, {0x2E140, 118}, {0x2E1C3, 119}, {0x2E201, 120}, {0x2E270, 121}, {0x2E27C, 122},
+	{0x2E2A2, 123}, {0x2E2B8, 124}, {0x2E2DB, 125}, {0x2E2DF, 126}, {0x2E2E3, 127}, {0x2E2EC, 128},
+	{0x2E301, 129}, {0x2E307, 130}, {0x2E34C, 131}, {0x2E354, 132}, {0x2E360, 133}, {0x2E365, 134},
+	{0x2E374, 135}, {0x2E381, 136}, {0x2E383, 137}, {0x2E398, 138}, {0x2E39B, 139}, {0x2E39F, 140},
+	{0x2E4D7, 141}, {0x2E4F0, 142}, {0x2E55C, 143}, {0x2E562, 144}, {0x2E564, 145}, {0x2E5BF, 146},
+	{0x2E5CD, 147}, {0x2E5DF, 148}, {0x2E5F7, 149}, {0x2E64C, 150}, {0x2E652, 151}, {0x2E65A, 152},
+	{0x2E665, 153}, {0x2E66D, 154}, {0x2E6B1, 155}, {0x2E6B2, 156}, {0x2E6C1, 157}, {0x2E72E, 158},
+	{0x2E73F, 159}, {0x2E77B, 160}, {0x2E77E, 161}, {0x2E781, 162}, {0x2E7EA, 163}, {0x2E815, 164},
+	{0x2E84E, 165}, {0x2E852, 166}, {0x2E864, 167}, {0x2E8F8, 168}, {0x2E907, 169}, {0x2E939, 170},
+	{0x2E974, 171}, {0x2E976, 172}, {0x2E984, 173}, {0x2E9C3, 174}, {0x2E9C4, 175}, {0x2E9C9, 176},
+	{0x2E9D3, 177}, {0x2E9EE, 178}, {0x2E9F6, 180}, {0x2EA00, 181}, {0x2EA26, 182}, {0x2EA36, 183},
+	{0x2EA37, 184}, {0x2EA5F, 185}, {0x2EA67, 186}, {0x2EA72, 187}, {0x2EAA6, 188}, {0x2EAB8, 189},
+	{0x2EABD, 190}, {0x2EAD4, 191}, {0x2EAD6, 192}, {0x2EAD9, 193}, {0x2EADC, 194}, {0x2EAEC, 195},
+	{0x2EB25, 196}, {0x2EB6B, 197}, {0x2EB71, 198}, {0x2EB7B, 199}, {0x2EB88, 200}, {0x2EB8F, 201},
+	{0x2EB90, 202}, {0x2EB94, 203}, {0x2EBA0, 204}, {0x2EBA1, 205}, {0x2EBA5, 206}, {0x2EBA6, 207},
+	{0x2EBAC, 208}, {0x2EBB0, 209}, {0x2EBBA, 210}, {0x2EBBD, 211}, {0x2EBDA, 212}, {0x2EBDB, 213},
+	{0x2EBE0, 214}, {0x2EBE1, 0}, {0x2F800, 1}, {0x2F801, 3}, {0x2F802, 4}, {0x2F803, 7},
+	{0x2F804, 9}, {0x2F80D, 16}, {0x2F80E, 10}, {0x2F811, 12}, {0x2F814, 11}, {0x2F815, 13},
+	{0x2F817, 14}, {0x2F819, 9}, {0x2F81A, 15}, {0x2F81C, 174}, {0x2F81D, 17}, {0x2F81E, 18},
+	{0x2F824, 19}, {0x2F828, 20}, {0x2F82B, 21}, {0x2F82C, 24}, {0x2F82F, 26}, {0x2F834, 27},
+	{0x2F835, 86}, {0x2F836, 29}, {0x2F839, 30}, {0x2F84B, 31}, {0x2F84C, 30}, {0x2F84D, 31},
+	{0x2F84E, 30}, {0x2F850, 18}, {0x2F851, 33}, {0x2F852, 32}, {0x2F85A, 33}, {0x2F85C, 34},
+	{0x2F85D, 36}, {0x2F85F, 37}, {0x2F860, 38}, {0x2F86C, 40}, {0x2F872, 41}, {0x2F874, 58},
+	{0x2F875, 43}, {0x2F877, 44}, {0x2F878, 45}, {0x2F879, 46}, {0x2F881, 162}, {0x2F882, 47},
+	{0x2F883, 49}, {0x2F885, 50}, {0x2F88A, 53}, {0x2F88F, 200}, {0x2F890, 55}, {0x2F893, 134},
+	{0x2F894, 57}, {0x2F896, 58}, {0x2F897, 72}, {0x2F898, 120}, {0x2F899, 59}, {0x2F89B, 60},
+	{0x2F89D, 61}, {0x2F8B2, 62}, {0x2F8B4, 64}, {0x2F8C8, 66}, {0x2F8CB, 71}, {0x2F8CC, 73},
+	{0x2F8CD, 72}, {0x2F8D2, 13}, {0x2F8D5, 72}, {0x2F8D6, 130}, {0x2F8D8, 74}, {0x2F8DA, 130},
+	{0x2F8DB, 75}, {0x2F8EF, 76}, {0x2F8F3, 77}, {0x2F8F4, 78}, {0x2F8F5, 79}, {0x2F8F8, 45},
+	{0x2F8F9, 80}, {0x2F8FA, 85}, {0x2F918, 86}, {0x2F91B, 12}, {0x2F91C, 86}, {0x2F921, 87},
+	{0x2F922, 91}, {0x2F923, 92}, {0x2F924, 93}, {0x2F926, 94}, {0x2F929, 96}, {0x2F933, 98},
+	{0x2F934, 100}, {0x2F935, 102}, {0x2F939, 51}, {0x2F93A, 104}, {0x2F93B, 106}, {0x2F93D, 108},
+	{0x2F940, 109}, {0x2F94C, 112}, {0x2F952, 113}, {0x2F957, 115}, {0x2F95C, 116}, {0x2F95D, 117},
+	{0x2F960, 118}, {0x2F966, 119}, {0x2F96A, 120}, {0x2F972, 121}, {0x2F974, 122}, {0x2F978, 123},
+	{0x2F979, 124}, {0x2F97A, 125}, {0x2F97B, 126}, {0x2F97C, 127}, {0x2F97D, 128}, {0x2F980, 74},
+	{0x2F981, 130}, {0x2F986, 38}, {0x2F987, 130}, {0x2F989, 74}, {0x2F98B, 134}, {0x2F98D, 160},
+	{0x2F98E, 137}, {0x2F98F, 140}, {0x2F992, 19}, {0x2F993, 140}, {0x2F9AB, 142}, {0x2F9AC, 140},
+	{0x2F9B3, 141}, {0x2F9B7, 142}, {0x2F9C3, 144}, {0x2F9C4, 145}, {0x2F9CA, 13}, {0x2F9CB, 147},
+	{0x2F9CC, 149}, {0x2F9D2, 152}, {0x2F9D3, 153}, {0x2F9D4, 154}, {0x2F9D7, 156}, {0x2F9D9, 18},
+	{0x2F9DA, 157}, {0x2F9DD, 20}, {0x2F9DE, 159}, {0x2F9E0, 162}, {0x2F9E2, 163}, {0x2F9E7, 167},
+	{0x2F9EE, 169}, {0x2F9F2, 170}, {0x2F9F3, 172}, {0x2F9F4, 46}, {0x2F9F5, 173}, {0x2F9F7, 176},
+	{0x2F9F8, 177}, {0x2F9FA, 178}, {0x2F9FB, 179}, {0x2F9FC, 181}, {0x2FA01, 182}, {0x2FA02, 184},
+	{0x2FA05, 186}, {0x2FA06, 187}, {0x2FA08, 188}, {0x2FA09, 190}, {0x2FA0B, 195}, {0x2FA0C, 196},
+	{0x2FA14, 198}, {0x2FA15, 200}, {0x2FA16, 202}, {0x2FA17, 204}, {0x2FA18, 205}, {0x2FA1A, 206},
+	{0x2FA1B, 207}, {0x2FA1C, 209}, {0x2FA1D, 211}, {0x2FA1E, 0}, {0x30000, 1}, {0x30020, 2},
+	{0x30029, 3}, {0x3002A, 4}, {0x30030, 5}, {0x30052, 6}, {0x30055, 7}, {0x3005C, 8},
+	{0x30061, 9}, {0x300CA, 10}, {0x300D6, 11}, {0x300DD, 12}, {0x300E6, 13}, {0x300EE, 14},
+	{0x300F5, 15}, {0x30110, 16}, {0x30111, 17}, {0x30119, 18}, {0x30149, 19}, {0x3015B, 20},
+	{0x30161, 21}, {0x30164, 22}, {0x3016A, 23}, {0x3016D, 24}, {0x30183, 25}, {0x3018A, 26},
+	{0x30195, 27}, {0x301AD, 28}, {0x301B0, 29}, {0x301C7, 30}, {0x3024D, 31}, {0x30255, 32},
+	{0x302C8, 33}, {0x302CE, 34}, {0x302CF, 35}, {0x302D0, 36}, {0x302D9, 37}, {0x302F5, 38},
+	{0x3031C, 39}, {0x30333, 40}, {0x30365, 41}, {0x30367, 42}, {0x3036D, 43}, {0x30370, 44},
+	{0x30388, 45}, {0x30389, 46}, {0x303C3, 47}, {0x303C7, 48}, {0x303CF, 49}, {0x303D1, 50},
+	{0x303E2, 51}, {0x303EF, 52}, {0x303F2, 53}, {0x30407, 55}, {0x3040D, 56}, {0x30413, 57},
+	{0x30423, 58}, {0x30426, 59}, {0x3042C, 60}, {0x3043A, 61}, {0x304A4, 62}, {0x304B4, 63},
+	{0x304B7, 64}, {0x30540, 65}, {0x30543, 66}, {0x3055A, 67}, {0x3055D, 69}, {0x30567, 70},
+	{0x30570, 71}, {0x30571, 72}, {0x305BF, 73}, {0x305C4, 74}, {0x305D0, 75}, {0x3064A, 76},
+	{0x3065A, 77}, {0x3067C, 78}, {0x30683, 79}, {0x3068B, 81}, {0x3068D, 82}, {0x306B7, 83},
+	{0x306BB, 84}, {0x306C4, 85}, {0x30776, 86}, {0x307FC, 87}, {0x3080B, 88}, {0x3080E, 90},
+	{0x30819, 91}, {0x30820, 92}, {0x30826, 93}, {0x3083D, 94}, {0x3086D, 96}, {0x3089B, 97},
+	{0x308A2, 98}, {0x308A7, 99}, {0x308AB, 100}, {0x308B0, 101}, {0x308B4, 102}, {0x308E0, 103},
+	{0x308E2, 104}, {0x3090C, 105}, {0x30912, 106}, {0x3091E, 107}, {0x30932, 108}, {0x3094B, 109},
+	{0x3098D, 110}, {0x30992, 111}, {0x309A4, 112}, {0x309ED, 113}, {0x30A1A, 114}, {0x30A1B, 115},
+	{0x30A44, 116}, {0x30A60, 117}, {0x30A6C, 118}, {0x30AA6, 119}, {0x30AD7, 120}, {0x30B42, 121},
+	{0x30B48, 122}, {0x30B57, 123}, {0x30B5D, 124}, {0x30B67, 125}, {0x30B6C, 126}, {0x30B70, 127},
+	{0x30B73, 128}, {0x30B7C, 129}, {0x30B7E, 130}, {0x30BEE, 131}, {0x30BF4, 132}, {0x30BF8, 134},
+	{0x30BFD, 135}, {0x30C0A, 136}, {0x30C0B, 137}, {0x30C14, 138}, {0x30C15, 139}, {0x30C1C, 140},
+	{0x30C98, 141}, {0x30CA9, 142}, {0x30CE7, 143}, {0x30CEA, 144}, {0x30CF0, 145}, {0x30D0B, 146},
+	{0x30D0E, 147}, {0x30D1F, 148}, {0x30D27, 149}, {0x30D90, 150}, {0x30D97, 151}, {0x30DA4, 152},
+	{0x30DB1, 154}, {0x30DEF, 155}, {0x30DF1, 156}, {0x30E01, 157}, {0x30E3A, 158}, {0x30E48, 159},
+	{0x30EA5, 160}, {0x30EAD, 161}, {0x30EAE, 162}, {0x30EDF, 163}, {0x30F03, 164}, {0x30F17, 165},
+	{0x30F18, 166}, {0x30F1F, 167}, {0x30FCB, 168}, {0x30FD7, 169}, {0x31001, 170}, {0x31045, 172},
+	{0x31051, 173}, {0x3106D, 174}, {0x3106F, 175}, {0x31070, 176}, {0x31071, 177}, {0x3107F, 178},
+	{0x3108D, 179}, {0x3108F, 180}, {0x31094, 181}, {0x310BC, 182}, {0x310E1, 184}, {0x3110B, 185},
+	{0x3110E, 186}, {0x31111, 187}, {0x3116D, 188}, {0x31174, 189}, {0x3117F, 190}, {0x31189, 191},
+	{0x3118A, 192}, {0x3118B, 193}, {0x31196, 194}, {0x311A3, 195}, {0x3121D, 196}, {0x312B6, 197},
+	{0x312BE, 198}, {0x312C3, 199}, {0x312EF, 200}, {0x312F2, 201}, {0x312F5, 202}, {0x312F6, 203},
+	{0x312FB, 205}, {0x3130A, 207}, {0x3130D, 208}, {0x31311, 209}, {0x31317, 210}, {0x3131A, 211},
+	{0x3133E, 212}, {0x31343, 213}, {0x31349, 214}, {0x3134B, 0}, {0x31350, 1}, {0x3136B, 2},
+	{0x31377, 3}, {0x31378, 4}, {0x31380, 5}, {0x31391, 6}, {0x31392, 7}, {0x31398, 8},
+	{0x3139A, 9}, {0x313E4, 10}, {0x313F1, 11}, {0x313F3, 12}, {0x313F9, 13}, {0x313FD, 14},
+	{0x313FF, 15}, {0x3140D, 17}, {0x31412, 18}, {0x31429, 19}, {0x31438, 20}, {0x31444, 21},
+	{0x31446, 22}, {0x3144A, 23}, {0x3144E, 24}, {0x31456, 25}, {0x31458, 26}, {0x3145C, 27},
+	{0x31462, 28}, {0x31471, 29}, {0x3147F, 30}, {0x31578, 31}, {0x31586, 32}, {0x31620, 33},
+	{0x31623, 34}, {0x31624, 35}, {0x31626, 36}, {0x3162D, 37}, {0x31641, 38}, {0x31675, 39},
+	{0x31683, 40}, {0x3169D, 41}, {0x316A3, 42}, {0x316AD, 43}, {0x316B0, 44}, {0x316C4, 45},
+	{0x316C5, 46}, {0x31716, 47}, {0x31717, 48}, {0x3171E, 49}, {0x31721, 50}, {0x3173D, 51},
+	{0x31743, 53}, {0x31751, 54}, {0x31754, 55}, {0x31759, 57}, {0x3175F, 58}, {0x31762, 59},
+	{0x3176A, 60}, {0x3177C, 61}, {0x317DC, 62}, {0x317E2, 63}, {0x317E9, 64}, {0x31857, 65},
+	{0x31858, 66}, {0x31862, 67}, {0x31868, 68}, {0x31869, 69}, {0x31871, 70}, {0x31879, 72},
+	{0x318B5, 73}, {0x318B8, 74}, {0x318BE, 75}, {0x31937, 76}, {0x3193B, 77}, {0x3194B, 78},
+	{0x31956, 79}, {0x3195B, 80}, {0x3195E, 82}, {0x31968, 83}, {0x3196A, 84}, {0x3196E, 85},
+	{0x31AA1, 86}, {0x31B34, 87}, {0x31B4A, 88}, {0x31B4B, 89}, {0x31B4D, 90}, {0x31B59, 91},
+	{0x31B62, 92}, {0x31B69, 93}, {0x31B73, 94}, {0x31BB2, 95}, {0x31BB3, 96}, {0x31C05, 97},
+	{0x31C08, 98}, {0x31C16, 99}, {0x31C17, 100}, {0x31C1C, 101}, {0x31C24, 102}, {0x31C47, 103},
+	{0x31C49, 104}, {0x31C74, 105}, {0x31C7A, 106}, {0x31C7F, 107}, {0x31C8B, 108}, {0x31CA3, 109},
+	{0x31CDB, 110}, {0x31CDD, 111}, {0x31CE8, 112}, {0x31D46, 113}, {0x31D79, 114}, {0x31D7C, 115},
+	{0x31DB0, 116}, {0x31DE3, 117}, {0x31DEE, 118}, {0x31E33, 119}, {0x31E6A, 120}, {0x31EB1, 121},
+	{0x31EB4, 122}, {0x31EC9, 123}, {0x31ED7, 124}, {0x31EE7, 125}, {0x31EF0, 126}, {0x31EF2, 127},
+	{0x31EF7, 128}, {0x31EFF, 129}, {0x31F00, 130}, {0x31F27, 131}, {0x31F28, 132}, {0x31F29, 133},
+	{0x31F2D, 134}, {0x31F33, 135}, {0x31F35, 136}, {0x31F36, 137}, {0x31F47, 138}, {0x31F49, 139},
+	{0x31F4B, 140}, {0x31FCE, 141}, {0x31FD8, 142}, {0x32017, 143}, {0x3201B, 144}, {0x32024, 145},
+	{0x32049, 146}, {0x3204E, 147}, {0x3205A, 148}, {0x32061, 149}, {0x32098, 150}, {0x3209D, 151},
+	{0x320A1, 152}, {0x320A4, 153}, {0x320A8, 154}, {0x320C7, 155}, {0x320C9, 156}, {0x320D4, 157},
+	{0x32117, 158}, {0x32133, 159}, {0x32144, 160}, {0x3214A, 161}, {0x3214B, 162}, {0x3218F, 163},
+	{0x321A5, 164}, {0x321C0, 165}, {0x321C1, 166}, {0x321C5, 167}, {0x32222, 168}, {0x32226, 169},
+	{0x32249, 170}, {0x32267, 172}, {0x32270, 173}, {0x32295, 174}, {0x32299, 175}, {0x3229A, 176},
+	{0x3229B, 177}, {0x322A2, 178}, {0x322A6, 179}, {0x322A7, 180}, {0x322AA, 181}, {0x322C4, 182},
+	{0x322D2, 183}, {0x322D4, 184}, {0x322ED, 185}, {0x322EE, 186}, {0x322F3, 187}, {0x3230C, 188},
+	{0x32316, 189}, {0x3231A, 190}, {0x32324, 192}, {0x32327, 194}, {0x32331, 195}, {0x3235A, 196},
+	{0x3238A, 197}, {0x3238B, 198}, {0x3238F, 199}, {0x32394, 200}, {0x32395, 201}, {0x32396, 203},
+	{0x32399, 206}, {0x3239A, 207}, {0x3239D, 208}, {0x323A1, 209}, {0x323A2, 211}, {0x323AC, 212},
+	{0x323AE, 213}, {0x323AF, 214}, {0x323B0, 0},
+}
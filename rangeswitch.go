@@ -0,0 +1,41 @@
+// Code generated by go test -run TestRangeSwitch -update; DO NOT EDIT.
+
+package ischinese
+
+// isChineseCharSwitch true if unicode code point is in commonTable, generated from it by go generate
+func isChineseCharSwitch(r rune) bool {
+	if r < 0xFF1F {
+		if r < 0xFE30 {
+			if r < 0x4E00 {
+				return 0x3000 <= r && r <= 0x303F ||
+					0x3300 <= r && r <= 0x4DBF
+			}
+			return 0x4E00 <= r && r <= 0x9FFC ||
+				0xF900 <= r && r <= 0xFAFF ||
+				0xFE10 <= r && r <= 0xFE19
+		}
+		if r < 0xFF08 {
+			return 0xFE30 <= r && r <= 0xFE4F ||
+				r == 0xFF01
+		}
+		return 0xFF08 <= r && r <= 0xFF09 ||
+			r == 0xFF0C ||
+			0xFF1A <= r && r <= 0xFF1B
+	}
+	if r < 0x2B740 {
+		if r < 0xFF3D {
+			return r == 0xFF1F ||
+				r == 0xFF3B
+		}
+		return r == 0xFF3D ||
+			0x20000 <= r && r <= 0x2A6DD ||
+			0x2A700 <= r && r <= 0x2B734
+	}
+	if r < 0x2CEB0 {
+		return 0x2B740 <= r && r <= 0x2B81D ||
+			0x2B820 <= r && r <= 0x2CEA1
+	}
+	return 0x2CEB0 <= r && r <= 0x2EBE0 ||
+		0x2F800 <= r && r <= 0x2FA1F ||
+		0x30000 <= r && r <= 0x3134F
+}
@@ -0,0 +1,78 @@
+package ischinese
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"testing"
+	"unicode"
+)
+
+var update = flag.Bool("update", false, "regenerate "+rangeSwitchFile)
+
+const rangeSwitchFile = "rangeswitch.go"
+
+// generateRangeSwitch generate the source of a function named name testing membership of sorted and merged ranges,
+// as an if-ladder bisecting the ranges down to a few comparisons
+func generateRangeSwitch(name string, ranges [][]rune) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go test -run TestRangeSwitch -update; DO NOT EDIT.\n\n")
+	buf.WriteString("package ischinese\n\n")
+	fmt.Fprintf(&buf, "// %s true if unicode code point is in commonTable, generated from it by go generate\n", name)
+	fmt.Fprintf(&buf, "func %s(r rune) bool {\n", name)
+	writeRangeSwitch(&buf, ranges)
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+func writeRangeSwitch(buf *bytes.Buffer, ranges [][]rune) {
+	if len(ranges) <= 3 {
+		buf.WriteString("return ")
+		for i, r := range ranges {
+			if i > 0 {
+				buf.WriteString(" ||\n")
+			}
+			if r[0] == r[1] {
+				fmt.Fprintf(buf, "r == 0x%04X", r[0])
+			} else {
+				fmt.Fprintf(buf, "0x%04X <= r && r <= 0x%04X", r[0], r[1])
+			}
+		}
+		buf.WriteString("\n")
+		return
+	}
+	mid := len(ranges) / 2
+	fmt.Fprintf(buf, "if r < 0x%04X {\n", ranges[mid][0])
+	writeRangeSwitch(buf, ranges[:mid])
+	buf.WriteString("}\n")
+	writeRangeSwitch(buf, ranges[mid:])
+}
+
+// TestRangeSwitch check rangeswitch.go is up to date with commonTable, go generate rewrites it with -update
+func TestRangeSwitch(t *testing.T) {
+	want, err := generateRangeSwitch("isChineseCharSwitch", commonTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		// the compiled isChineseCharSwitch is the former one until the next build
+		if err := os.WriteFile(rangeSwitchFile, want, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	got, err := os.ReadFile(rangeSwitchFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date with commonTable, run go generate", rangeSwitchFile)
+	}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if got, want := isChineseCharSwitch(r), inRanges(r, commonTable); got != want {
+			t.Fatalf("isChineseCharSwitch(%U) = %v, want %v", r, got, want)
+		}
+	}
+}
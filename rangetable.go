@@ -0,0 +1,52 @@
+package ischinese
+
+import (
+	"sort"
+	"unicode"
+)
+
+// CJKRangeTable Chinese unicode as a unicode.RangeTable, built from the same ranges as IsChinese, to be used with unicode.Is and unicode.In
+var CJKRangeTable = newRangeTable(commonRange)
+
+// mergeRanges sort ranges and merge the overlapping or adjacent ones
+func mergeRanges(ranges [][]rune) [][]rune {
+	sorted := make([][]rune, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+	var merged [][]rune
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1]+1 {
+			if r[1] > merged[n-1][1] {
+				merged[n-1][1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, []rune{r[0], r[1]})
+	}
+	return merged
+}
+
+func newRangeTable(ranges [][]rune) *unicode.RangeTable {
+	table := &unicode.RangeTable{}
+	for _, r := range mergeRanges(ranges) {
+		lo, hi := r[0], r[1]
+		if lo <= 0xFFFF {
+			hi16 := hi
+			if hi16 > 0xFFFF {
+				hi16 = 0xFFFF
+			}
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi16), Stride: 1})
+			if hi16 <= unicode.MaxLatin1 {
+				table.LatinOffset++
+			}
+			if hi <= 0xFFFF {
+				continue
+			}
+			lo = 0x10000
+		}
+		table.R32 = append(table.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+	}
+	return table
+}
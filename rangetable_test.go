@@ -0,0 +1,47 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestCJKRangeTable(t *testing.T) {
+	if !unicode.Is(CJKRangeTable, '中') {
+		t.Errorf("unicode.Is(CJKRangeTable, '中') = false, want true")
+	}
+	if unicode.Is(CJKRangeTable, 'a') {
+		t.Errorf("unicode.Is(CJKRangeTable, 'a') = true, want false")
+	}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if got, want := unicode.Is(CJKRangeTable, r), isChineseChar(r); got != want {
+			t.Fatalf("unicode.Is(CJKRangeTable, %U) = %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestNewRangeTable(t *testing.T) {
+	got := newRangeTable([][]rune{
+		{'\U00020000', '\U00020010'},
+		{'\uFFF0', '\U00010005'},
+		{'b', 'c'},
+		{'\u4E00', '\u4E10'},
+		{'\u4E05', '\u4E20'},
+		{'\u4E21', '\u4E30'},
+	})
+	want := &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 'b', Hi: 'c', Stride: 1},
+			{Lo: 0x4E00, Hi: 0x4E30, Stride: 1},
+			{Lo: 0xFFF0, Hi: 0xFFFF, Stride: 1},
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x10000, Hi: 0x10005, Stride: 1},
+			{Lo: 0x20000, Hi: 0x20010, Stride: 1},
+		},
+		LatinOffset: 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newRangeTable() = %v, want %v", got, want)
+	}
+}
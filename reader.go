@@ -0,0 +1,53 @@
+package ischinese
+
+import (
+	"bufio"
+	"io"
+)
+
+// IsChineseReader true if more than 50% of unicode code points read from r are Chinese unicode.
+// Runes are read incrementally, so memory stays flat regardless of input size, empty input is true like IsChinese.
+func IsChineseReader(r io.Reader) (bool, error) {
+	return Default().IsChineseReader(r)
+}
+
+// IsSimplifiedChineseReader true if more than 50% of unicode code points read from r are simplified Chinese unicode
+func IsSimplifiedChineseReader(r io.Reader) (bool, error) {
+	return Default().IsSimplifiedChineseReader(r)
+}
+
+// IsTraditionalChineseReader true if more than 50% of unicode code points read from r are traditional Chinese unicode
+func IsTraditionalChineseReader(r io.Reader) (bool, error) {
+	return Default().IsTraditionalChineseReader(r)
+}
+
+func nonPureReaderHelper(rd io.Reader, f func(rune) bool, threshold float64) (bool, error) {
+	br := bufio.NewReader(rd)
+	c := runeCounter{f: f}
+	for {
+		r, _, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return false, err
+		}
+		c.add(r)
+	}
+	return c.mostly(threshold), nil
+}
+
+// maxLineSize the longest line ClassifyLines accepts, in bytes
+const maxLineSize = 1 << 20
+
+// ClassifyLines call fn with each line read from r, its 1 based line number and its Classify result, without reading r at once.
+// Line endings "\n" and "\r\n" are dropped. Lines longer than 1 MiB stop the scan with bufio.ErrTooLong, which is returned like read errors.
+func ClassifyLines(r io.Reader, fn func(lineNo int, line string, s Script)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		fn(lineNo, line, Classify(line))
+	}
+	return scanner.Err()
+}
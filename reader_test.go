@@ -0,0 +1,94 @@
+package ischinese
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestIsChineseReader(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello world",
+		},
+		{
+			s: "机车abc",
+		},
+		{
+			s: "大劉說說，中国",
+		},
+		{
+			s: "喜欢锻炼的人，身体应该比较好，天天锻炼的人（比如运动员），就不一定好，旅游也是如此。",
+		},
+		{
+			s: "《射鵰英雄傳》小說前後一共有三個版本\U00020000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// one byte per read splits multi-byte runes across reads
+			got, err := IsChineseReader(iotest.OneByteReader(strings.NewReader(tt.s)))
+			if err != nil || got != IsChinese(tt.s) {
+				t.Errorf("IsChineseReader() = %v, %v, want %v", got, err, IsChinese(tt.s))
+			}
+			got, err = IsSimplifiedChineseReader(iotest.OneByteReader(strings.NewReader(tt.s)))
+			if err != nil || got != IsSimplifiedChinese(tt.s) {
+				t.Errorf("IsSimplifiedChineseReader() = %v, %v, want %v", got, err, IsSimplifiedChinese(tt.s))
+			}
+			got, err = IsTraditionalChineseReader(iotest.OneByteReader(strings.NewReader(tt.s)))
+			if err != nil || got != IsTraditionalChinese(tt.s) {
+				t.Errorf("IsTraditionalChineseReader() = %v, %v, want %v", got, err, IsTraditionalChinese(tt.s))
+			}
+		})
+	}
+}
+
+func TestIsChineseReaderError(t *testing.T) {
+	errRead := errors.New("read error")
+	r := io.MultiReader(strings.NewReader("你好"), iotest.ErrReader(errRead))
+	if _, err := IsChineseReader(r); !errors.Is(err, errRead) {
+		t.Errorf("IsChineseReader() error = %v, want %v", err, errRead)
+	}
+}
+
+func TestClassifyLines(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	type line struct {
+		lineNo int
+		line   string
+		s      Script
+	}
+	var got []line
+	err := ClassifyLines(strings.NewReader("这是简体中文\r\n這是繁體中文\n\nhello world"), func(lineNo int, l string, s Script) {
+		got = append(got, line{lineNo, l, s})
+	})
+	want := []line{
+		{1, "这是简体中文", ScriptSimplified},
+		{2, "這是繁體中文", ScriptTraditional},
+		{3, "", ScriptUnknown},
+		{4, "hello world", ScriptNonChinese},
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassifyLines() visited %v, %v, want %v", got, err, want)
+	}
+
+	long := strings.Repeat("中", maxLineSize)
+	if err := ClassifyLines(strings.NewReader(long), func(int, string, Script) {}); !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("ClassifyLines() error = %v, want %v", err, bufio.ErrTooLong)
+	}
+	errRead := errors.New("read error")
+	r := io.MultiReader(strings.NewReader("你好\n"), iotest.ErrReader(errRead))
+	if err := ClassifyLines(r, func(int, string, Script) {}); !errors.Is(err, errRead) {
+		t.Errorf("ClassifyLines() error = %v, want %v", err, errRead)
+	}
+}
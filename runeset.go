@@ -0,0 +1,68 @@
+package ischinese
+
+import "sort"
+
+// runeSet membership test of unicode code points
+type runeSet interface {
+	contains(r rune) bool
+}
+
+// dictRuneSet keys of a variant dictionary
+type dictRuneSet map[rune]rune
+
+func (s dictRuneSet) contains(r rune) bool {
+	_, ok := s[r]
+	return ok
+}
+
+// runeMap read only map of unicode code points, keys sorted for binary search with values at the same index.
+// Two int32 slices take a fraction of the memory of a map[rune]rune.
+type runeMap struct {
+	keys   []rune
+	values []rune
+}
+
+func newRuneMap(m map[rune]rune) runeMap {
+	keys := make([]rune, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	values := make([]rune, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return runeMap{keys: keys, values: values}
+}
+
+func (m runeMap) lookup(r rune) (rune, bool) {
+	lo, hi := 0, len(m.keys)
+	for lo < hi {
+		i := int(uint(lo+hi) >> 1)
+		switch {
+		case r < m.keys[i]:
+			hi = i
+		case r > m.keys[i]:
+			lo = i + 1
+		default:
+			return m.values[i], true
+		}
+	}
+	return 0, false
+}
+
+func (m runeMap) contains(r rune) bool {
+	_, ok := m.lookup(r)
+	return ok
+}
+
+// toMap copy m into a new map
+func (m runeMap) toMap() map[rune]rune {
+	res := make(map[rune]rune, len(m.keys))
+	for i, k := range m.keys {
+		res[k] = m.values[i]
+	}
+	return res
+}
@@ -0,0 +1,160 @@
+package ischinese
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// mapRuneSet hash set
+type mapRuneSet map[rune]struct{}
+
+func newMapRuneSet(runes []rune) mapRuneSet {
+	s := make(mapRuneSet, len(runes))
+	for _, r := range runes {
+		s[r] = struct{}{}
+	}
+	return s
+}
+
+func (s mapRuneSet) contains(r rune) bool {
+	_, ok := s[r]
+	return ok
+}
+
+// sortedRuneSet sorted slice, searched by binary search
+type sortedRuneSet []rune
+
+func newSortedRuneSet(runes []rune) sortedRuneSet {
+	s := make(sortedRuneSet, len(runes))
+	copy(s, runes)
+	sort.Slice(s, func(i, j int) bool {
+		return s[i] < s[j]
+	})
+	return s
+}
+
+func (s sortedRuneSet) contains(r rune) bool {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i] >= r
+	})
+	return i < len(s) && s[i] == r
+}
+
+// bitRuneSet one bit per code point up to the largest member
+type bitRuneSet []uint64
+
+func newBitRuneSet(runes []rune) bitRuneSet {
+	var max rune
+	for _, r := range runes {
+		if r > max {
+			max = r
+		}
+	}
+	s := make(bitRuneSet, max/64+1)
+	for _, r := range runes {
+		s[r/64] |= 1 << uint(r%64)
+	}
+	return s
+}
+
+func (s bitRuneSet) contains(r rune) bool {
+	if r < 0 || int(r/64) >= len(s) {
+		return false
+	}
+	return s[r/64]&(1<<uint(r%64)) != 0
+}
+
+func dictionaryKeys() []rune {
+	return append([]rune(nil), loadDictionaries().traditionalDict.keys...)
+}
+
+func TestRuneSet(t *testing.T) {
+	keys := dictionaryKeys()
+	sets := map[string]runeSet{
+		"map":    newMapRuneSet(keys),
+		"sorted": newSortedRuneSet(keys),
+		"bitset": newBitRuneSet(keys),
+	}
+	for name, set := range sets {
+		t.Run(name, func(t *testing.T) {
+			for _, r := range []rune{-1, 0, 'a', '国', '國', '\U0002A6DD', '\U0010FFFF'} {
+				want := loadDictionaries().traditionalDict.contains(r)
+				if got := set.contains(r); got != want {
+					t.Errorf("contains(%U) = %v, want %v", r, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRuneMap(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	file, err := embedded.Open("Unihan_Variants.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	simplified := make(map[rune]rune)
+	traditional := make(map[rune]rune)
+	if err := parseVariants(file, simplified, traditional); err != nil {
+		t.Fatal(err)
+	}
+	for name, dict := range map[string]map[rune]rune{"simplified": simplified, "traditional": traditional} {
+		t.Run(name, func(t *testing.T) {
+			m := newRuneMap(dict)
+			for r := rune(-1); r <= unicode.MaxRune; r++ {
+				got, gotOk := m.lookup(r)
+				want, wantOk := dict[r]
+				if got != want || gotOk != wantOk {
+					t.Fatalf("lookup(%U) = %U, %v, want %U, %v", r, got, gotOk, want, wantOk)
+				}
+			}
+			if got := m.toMap(); !reflect.DeepEqual(got, dict) {
+				t.Errorf("toMap() differs from the source map")
+			}
+		})
+	}
+}
+
+// heapSize estimate the heap memory held by the set built by build, negative if the GC freed more than the set holds
+func heapSize(build func() runeSet) (runeSet, int64) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	set := build()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	return set, int64(after.HeapAlloc) - int64(before.HeapAlloc)
+}
+
+func BenchmarkDictionaryLookup(b *testing.B) {
+	keys := dictionaryKeys()
+	// mostly Chinese text with some latin, both simplified and traditional
+	text := []rune(strings.Repeat("在军队中，汤和算是个奇特的人。然而連載《射鵰英雄傳》期間，因為金庸在長城電影公司擔任編劇和導演。hello world 2021", 10))
+	builders := []struct {
+		name  string
+		build func() runeSet
+	}{
+		{"map", func() runeSet { return newMapRuneSet(keys) }},
+		{"sorted", func() runeSet { return newSortedRuneSet(keys) }},
+		{"bitset", func() runeSet { return newBitRuneSet(keys) }},
+		{"runemap", func() runeSet { return newRuneMap(loadDictionaries().traditionalDict.toMap()) }},
+	}
+	for _, bb := range builders {
+		b.Run(bb.name, func(b *testing.B) {
+			set, size := heapSize(bb.build)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, r := range text {
+					set.contains(r)
+				}
+			}
+			b.ReportMetric(float64(size), "set-bytes")
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package ischinese
+
+import "unicode/utf8"
+
+// IsChineseSampled approximate IsChinese on huge strings by checking sampleRunes unicode code points,
+// one at a pseudo random byte offset in each of sampleRunes equal spans of s, the same offsets for the same length.
+// As a sampled code point of n bytes covers n bytes, it weighs 1/n, so that ASCII is not underrepresented against Chinese.
+// The verdict is an estimate which may differ from IsChinese when the ratio is close to 50% or the text is unevenly mixed.
+// Strings shorter than sampleRunes*4 bytes, or sampleRunes of 0 or less, are checked in full with IsChinese.
+func IsChineseSampled(s string, sampleRunes int) bool {
+	// compared by division, sampleRunes*utf8.UTFMax may overflow
+	if sampleRunes <= 0 || sampleRunes >= len(s)/utf8.UTFMax {
+		return IsChinese(s)
+	}
+	var chinese, total float64
+	// xorshift, jitter against periodic text, e.g. a repeated line, which evenly strided offsets could hit in the same place
+	state := uint64(len(s)) | 1
+	for i := 0; i < sampleRunes; i++ {
+		state ^= state << 13
+		state ^= state >> 7
+		state ^= state << 17
+		lo := int64(i) * int64(len(s)) / int64(sampleRunes)
+		hi := int64(i+1) * int64(len(s)) / int64(sampleRunes)
+		offset := int(lo + int64(state%uint64(hi-lo)))
+		// the start of the code point the offset falls in
+		for offset > 0 && !utf8.RuneStart(s[offset]) {
+			offset--
+		}
+		r, size := utf8.DecodeRuneInString(s[offset:])
+		weight := 1 / float64(size)
+		total += weight
+		if isChineseChar(r) {
+			chinese += weight
+		}
+	}
+	return chinese/total > 0.5
+}
@@ -0,0 +1,82 @@
+package ischinese
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestIsChineseSampled(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		sampleRunes int
+		want        bool
+	}{
+		{
+			s:           "",
+			sampleRunes: 100,
+			want:        true,
+		},
+		{
+			s:           "机车abc",
+			sampleRunes: 100,
+			want:        IsChinese("机车abc"),
+		},
+		{
+			name:        "mostly Chinese",
+			s:           strings.Repeat("在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户 (ok) ", 10000),
+			sampleRunes: 1000,
+			want:        true,
+		},
+		// 6 ideographs of 3 bytes against 9 ASCII, Chinese is most bytes but not most code points
+		{
+			name:        "mostly ASCII code points",
+			s:           strings.Repeat("中文字 hello 汉字 ", 10000),
+			sampleRunes: 1000,
+			want:        false,
+		},
+		{
+			name:        "mostly English",
+			s:           strings.Repeat("The quick brown fox jumps over the lazy dog. 狐狸", 10000),
+			sampleRunes: 1000,
+			want:        false,
+		},
+		{
+			name:        "sampleRunes overflow",
+			s:           strings.Repeat("中文字 hello 汉字 ", 100),
+			sampleRunes: math.MaxInt,
+			want:        false,
+		},
+		{
+			name:        "no sampling",
+			s:           strings.Repeat("中文字 hello 汉字 ", 100),
+			sampleRunes: 0,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsChineseSampled(tt.s, tt.sampleRunes); got != tt.want {
+				t.Errorf("IsChineseSampled() = %v, want %v", got, tt.want)
+			}
+			if got := IsChinese(tt.s); got != tt.want {
+				t.Errorf("IsChinese() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkIsChineseSampled(b *testing.B) {
+	s := strings.Repeat("在军队中，汤和算是个奇特的人，他在朱元璋刚参军时，已经是千户 (ok) ", 100000)
+	b.Run("full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			IsChinese(s)
+		}
+	})
+	b.Run("sampled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			IsChineseSampled(s, 1000)
+		}
+	})
+}
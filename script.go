@@ -0,0 +1,124 @@
+package ischinese
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// script names returned by the per rune classification
+const (
+	scriptHan      = "han"
+	scriptKana     = "kana"
+	scriptHangul   = "hangul"
+	scriptBopomofo = "bopomofo"
+	scriptLatin    = "latin"
+	scriptCyrillic = "cyrillic"
+	scriptGreek    = "greek"
+	scriptArabic   = "arabic"
+	scriptCommon   = "common"
+	scriptOther    = "other"
+)
+
+var scriptTables = []struct {
+	name   string
+	tables []*unicode.RangeTable
+}{
+	{scriptKana, []*unicode.RangeTable{unicode.Hiragana, unicode.Katakana}},
+	{scriptHangul, []*unicode.RangeTable{unicode.Hangul}},
+	{scriptBopomofo, []*unicode.RangeTable{unicode.Bopomofo}},
+	{scriptLatin, []*unicode.RangeTable{unicode.Latin}},
+	{scriptCyrillic, []*unicode.RangeTable{unicode.Cyrillic}},
+	{scriptGreek, []*unicode.RangeTable{unicode.Greek}},
+	{scriptArabic, []*unicode.RangeTable{unicode.Arabic}},
+}
+
+// isNeutralChar true for whitespace and punctuation, including Chinese punctuation
+func isNeutralChar(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || isPunctuationChar(r)
+}
+
+// scriptOf classify unicode code point as one of han, kana, hangul, bopomofo, latin, cyrillic, greek, arabic,
+// common (neutral characters, digits and symbols) or other
+func scriptOf(r rune) string {
+	if isNeutralChar(r) {
+		return scriptCommon
+	}
+	if isChineseChar(r) {
+		return scriptHan
+	}
+	for _, script := range scriptTables {
+		if unicode.In(r, script.tables...) {
+			return script.name
+		}
+	}
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return scriptCommon
+	}
+	return scriptOther
+}
+
+// FirstScript return the script of the first unicode code point which is neither whitespace nor punctuation (Chinese punctuation included),
+// one of "han", "kana", "hangul", "bopomofo", "latin", "cyrillic", "greek", "arabic", "common" (digits and symbols) or "other".
+// Return "" if there is no such code point.
+func FirstScript(s string) string {
+	for _, r := range s {
+		if isNeutralChar(r) {
+			continue
+		}
+		return scriptOf(r)
+	}
+	return ""
+}
+
+// scriptRuns call fn with each maximal run of code points sharing the same script, in order
+func scriptRuns(s string, fn func(text, script string)) {
+	start, current := 0, ""
+	for i, r := range s {
+		script := scriptOf(r)
+		if script != current && i > start {
+			fn(s[start:i], current)
+			start = i
+		}
+		current = script
+	}
+	if start < len(s) {
+		fn(s[start:], current)
+	}
+}
+
+// RenderSpans apply wrap to each maximal run of code points sharing the same script and concatenate the results,
+// e.g. to render "你好world" as `<span class="han">你好</span><span class="latin">world</span>`.
+// wrap is called in order, scripts are those returned by FirstScript, whitespace and punctuation form "common" runs.
+func RenderSpans(s string, wrap func(text, script string) string) string {
+	var b strings.Builder
+	scriptRuns(s, func(text, script string) {
+		b.WriteString(wrap(text, script))
+	})
+	return b.String()
+}
+
+// requiredScripts return the sorted distinct scripts of s, "common" only if includeCommon
+func requiredScripts(s string, includeCommon bool) []string {
+	seen := make(map[string]struct{})
+	var scripts []string
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == scriptCommon && !includeCommon {
+			continue
+		}
+		if _, ok := seen[script]; ok {
+			continue
+		}
+		seen[script] = struct{}{}
+		scripts = append(scripts, script)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// RequiredScripts return the sorted distinct scripts of s, e.g. to load font subsets,
+// using the names returned by FirstScript except "common" (whitespace, punctuation, digits and symbols), see WithCommonScript
+func RequiredScripts(s string) []string {
+	return Default().RequiredScripts(s)
+}
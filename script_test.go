@@ -0,0 +1,119 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFirstScript(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    " ，。! ",
+			want: "",
+		},
+		{
+			s:    "「你好」world",
+			want: "han",
+		},
+		{
+			s:    "  hello 世界",
+			want: "latin",
+		},
+		{
+			s:    "こんにちは世界",
+			want: "kana",
+		},
+		{
+			s:    "안녕하세요",
+			want: "hangul",
+		},
+		{
+			s:    "ㄅㄆㄇ",
+			want: "bopomofo",
+		},
+		{
+			s:    "（2021）年",
+			want: "common",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstScript(tt.s); got != tt.want {
+				t.Errorf("FirstScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderSpans(t *testing.T) {
+	wrap := func(text, script string) string {
+		return `<span class="` + script + `">` + text + `</span>`
+	}
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			s:    "",
+			want: "",
+		},
+		{
+			s:    "你好world",
+			want: `<span class="han">你好</span><span class="latin">world</span>`,
+		},
+		{
+			s:    "你好, world！",
+			want: `<span class="han">你好</span><span class="common">, </span><span class="latin">world</span><span class="common">！</span>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderSpans(tt.s, wrap); got != tt.want {
+				t.Errorf("RenderSpans() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredScripts(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		s    string
+		want []string
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    " ，。",
+			want: nil,
+		},
+		{
+			s:    "你好, world! こんにちは 2021",
+			want: []string{"han", "kana", "latin"},
+		},
+		{
+			opts: []Option{WithCommonScript(true)},
+			s:    "你好, world!",
+			want: []string{"common", "han", "latin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDetector(tt.opts...).RequiredScripts(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RequiredScripts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package ischinese
+
+import "unicode"
+
+var (
+	terminalPunctuation = makeRuneSet("。！？")
+	closingPunctuation  = makeRuneSet("」』”’）》】")
+)
+
+// SentenceBoundaries return byte offsets where sentences start, based on two heuristics:
+//   - after Chinese terminal punctuation 。！？, including closing quotes and brackets which follow it, e.g. after "。」"
+//   - where a Latin upper case letter follows an ideograph, ignoring whitespace in between, e.g. before "Go" in "你好 Go is fun"
+//
+// Offsets 0 and len(s) are never returned. ASCII terminal punctuation, abbreviations and lower case Latin are not considered,
+// so mixed text with English sentences is only partially split.
+func SentenceBoundaries(s string) []int {
+	var boundaries []int
+	afterTerminal := false
+	prevHan := false
+	for i, r := range s {
+		if _, ok := terminalPunctuation[r]; ok {
+			afterTerminal = true
+			prevHan = false
+			continue
+		}
+		if _, ok := closingPunctuation[r]; ok && afterTerminal {
+			continue
+		}
+		if afterTerminal {
+			boundaries = append(boundaries, i)
+			afterTerminal = false
+		} else if prevHan && i > 0 && unicode.IsUpper(r) && unicode.Is(unicode.Latin, r) {
+			boundaries = append(boundaries, i)
+		}
+		if !unicode.IsSpace(r) {
+			prevHan = isIdeographChar(r)
+		}
+	}
+	return boundaries
+}
+
+// Segment a contiguous run of Chinese or non Chinese unicode code points, Start is its byte offset
+type Segment struct {
+	Text      string
+	IsChinese bool
+	Start     int
+}
+
+// Segments split s into alternating runs of Chinese and non Chinese unicode code points, e.g. for mixed language layout.
+// Chinese punctuation is Chinese, whitespace between Chinese runs is not.
+func Segments(s string) []Segment {
+	var res []Segment
+	for i, r := range s {
+		chinese := isChineseChar(r)
+		if len(res) > 0 && res[len(res)-1].IsChinese == chinese {
+			continue
+		}
+		if len(res) > 0 {
+			res[len(res)-1].Text = s[res[len(res)-1].Start:i]
+		}
+		res = append(res, Segment{IsChinese: chinese, Start: i})
+	}
+	if len(res) > 0 {
+		res[len(res)-1].Text = s[res[len(res)-1].Start:]
+	}
+	return res
+}
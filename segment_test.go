@@ -0,0 +1,82 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSentenceBoundaries(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []int
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    "你好。",
+			want: nil,
+		},
+		{
+			s:    "你好。世界！再见？？ok",
+			want: []int{9, 18, 30},
+		},
+		{
+			s:    "他说：「好。」然后",
+			want: []int{21},
+		},
+		{
+			s:    "我用 Go 写代码",
+			want: []int{7},
+		},
+		{
+			s:    "我用go写代码",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SentenceBoundaries(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SentenceBoundaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []Segment
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    "hello世界foo你好",
+			want: []Segment{{"hello", false, 0}, {"世界", true, 5}, {"foo", false, 11}, {"你好", true, 14}},
+		},
+		{
+			s:    "你好，世界",
+			want: []Segment{{"你好，世界", true, 0}},
+		},
+		{
+			s:    "你好 world!",
+			want: []Segment{{"你好", true, 0}, {" world!", false, 6}},
+		},
+		{
+			s:    "\xff你",
+			want: []Segment{{"\xff", false, 0}, {"你", true, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Segments(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ischinese
+
+import "unicode"
+
+// TranslationQualitySignals compute signals which a caller can combine to guess machine translated versus human written Chinese,
+// no verdict is given. Signals with a zero denominator are 0.
+//   - punctuation_density: Chinese punctuation code points / all code points
+//   - ascii_punctuation_ratio: ASCII punctuation / (ASCII punctuation + Chinese punctuation)
+//   - measure_word_ratio: ideographs which are common measure words (see ContainsMeasureWord) / all ideographs
+//   - rare_char_ratio: ideographs outside kIICore / all ideographs, -1 until LoadIRGSources has loaded kIICore, which is not embedded
+func TranslationQualitySignals(s string) map[string]float64 {
+	var total, chinesePunct, asciiPunct, ideographs, measure, rare float64
+	iiCore := loadedIICore()
+	for _, r := range s {
+		total++
+		switch {
+		case isPunctuationChar(r):
+			chinesePunct++
+		case r < 0x80 && unicode.IsPunct(r):
+			asciiPunct++
+		case isIdeographChar(r):
+			ideographs++
+			if isMeasureWord(r) {
+				measure++
+			}
+			if _, ok := iiCore[r]; !ok {
+				rare++
+			}
+		}
+	}
+	div := func(a, b float64) float64 {
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	}
+	signals := map[string]float64{
+		"punctuation_density":     div(chinesePunct, total),
+		"ascii_punctuation_ratio": div(asciiPunct, asciiPunct+chinesePunct),
+		"measure_word_ratio":      div(measure, ideographs),
+		"rare_char_ratio":         -1,
+	}
+	if iiCore != nil {
+		signals["rare_char_ratio"] = div(rare, ideographs)
+	}
+	return signals
+}
@@ -0,0 +1,56 @@
+package ischinese
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTranslationQualitySignals(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want map[string]float64
+	}{
+		{
+			s: "",
+			want: map[string]float64{
+				"punctuation_density":     0,
+				"ascii_punctuation_ratio": 0,
+				"measure_word_ratio":      0,
+				"rare_char_ratio":         -1,
+			},
+		},
+		{
+			s: "一个人，两只猫!",
+			want: map[string]float64{
+				"punctuation_density":     0.125,
+				"ascii_punctuation_ratio": 0.5,
+				"measure_word_ratio":      2.0 / 6,
+				"rare_char_ratio":         -1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TranslationQualitySignals(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TranslationQualitySignals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslationQualitySignalsRareChar(t *testing.T) {
+	defer func() {
+		iiCoreDict = nil
+		radicalDict = nil
+	}()
+	err := LoadIRGSources(strings.NewReader("# Unihan_IRGSources.txt\nU+4E00\tkIICore\tAGTJHKMP\nU+4EBA\tkIICore\tAGTJHKMP\nU+4E00\tkIRG_GSource\tG0-523B\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := TranslationQualitySignals("一人鼺鼺")
+	if got["rare_char_ratio"] != 0.5 {
+		t.Errorf("TranslationQualitySignals() rare_char_ratio = %v, want %v", got["rare_char_ratio"], 0.5)
+	}
+}
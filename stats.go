@@ -0,0 +1,124 @@
+package ischinese
+
+import "unicode/utf8"
+
+// ChineseByteSizeHistogram count Chinese unicode code points by UTF-8 encoded length.
+// Keys are 3 for code points in the Basic Multilingual Plane and 4 for supplementary planes (Extension B and later),
+// non Chinese code points are excluded.
+func ChineseByteSizeHistogram(s string) map[int]int {
+	histogram := make(map[int]int)
+	for _, r := range s {
+		if isChineseChar(r) {
+			histogram[utf8.RuneLen(r)]++
+		}
+	}
+	return histogram
+}
+
+// ChineseDensityProfile split s into buckets segments of equal rune count and return the ratio of Chinese unicode code points in each.
+// The i-th rune of n runes goes to segment i*buckets/n, so segment sizes differ by at most one rune,
+// and segments left empty when s has fewer runes than buckets have ratio 0.
+func ChineseDensityProfile(s string, buckets int) []float64 {
+	if buckets <= 0 {
+		return nil
+	}
+	runes := []rune(s)
+	counter := make([]float64, buckets)
+	total := make([]float64, buckets)
+	for i, r := range runes {
+		bucket := i * buckets / len(runes)
+		total[bucket]++
+		if isChineseChar(r) {
+			counter[bucket]++
+		}
+	}
+	profile := make([]float64, buckets)
+	for i := range profile {
+		if total[i] > 0 {
+			profile[i] = counter[i] / total[i]
+		}
+	}
+	return profile
+}
+
+// MostChinese return the index and value of the candidate with the highest ratio of Chinese unicode code points.
+// Ties return the first one, no candidates return -1 and "".
+func MostChinese(candidates ...string) (int, string) {
+	index := -1
+	best := -1.0
+	for i, candidate := range candidates {
+		if ratio := ChineseRatio(candidate); ratio > best {
+			index, best = i, ratio
+		}
+	}
+	if index < 0 {
+		return -1, ""
+	}
+	return index, candidates[index]
+}
+
+// CoverageRatio return the ratio of CJK ideographs in s which are in allowed, e.g. an approved list of common characters.
+// Non ideograph code points, punctuation included, are excluded from the denominator, s without ideographs return 0.
+func CoverageRatio(s string, allowed map[rune]struct{}) float64 {
+	var counter float64
+	var total float64
+	for _, r := range s {
+		if !isIdeographChar(r) {
+			continue
+		}
+		total++
+		if _, ok := allowed[r]; ok {
+			counter++
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return counter / total
+}
+
+// DetectionStats breakdown of the unicode code points of a string, see Stats
+type DetectionStats struct {
+	TotalRunes int
+	// ChineseRunes sum of SimplifiedOnly, TraditionalOnly, Shared and Punctuation
+	ChineseRunes int
+
+	// each unicode code point is in exactly one of the buckets below, they sum to TotalRunes
+	SimplifiedOnly  int
+	TraditionalOnly int
+	// Shared Chinese unicode valid in both scripts, e.g. 人, including CJK symbols like ㎡
+	Shared int
+	// Punctuation Chinese punctuation, see IsCJKPunctuation, it is Chinese unicode but only counted here
+	Punctuation int
+	// ASCII including ASCII punctuation and spaces
+	ASCII int
+	// Other non Chinese unicode, e.g. kana or emoji
+	Other int
+}
+
+// Stats count the unicode code points of s by bucket in a single pass, see ForEachRune for the classification
+func Stats(s string) DetectionStats {
+	var stats DetectionStats
+	ForEachRune(s, func(r rune, _ int, class RuneClass) {
+		stats.TotalRunes++
+		switch class {
+		case RuneSimplified:
+			stats.SimplifiedOnly++
+		case RuneTraditional:
+			stats.TraditionalOnly++
+		case RuneShared:
+			stats.Shared++
+		case RunePunctuation:
+			stats.Punctuation++
+		default:
+			if r < utf8.RuneSelf {
+				stats.ASCII++
+			} else {
+				stats.Other++
+			}
+			return
+		}
+		stats.ChineseRunes++
+	})
+	return stats
+}
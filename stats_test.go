@@ -0,0 +1,238 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChineseByteSizeHistogram(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want map[int]int
+	}{
+		{
+			s:    "",
+			want: map[int]int{},
+		},
+		{
+			s:    "hello world",
+			want: map[int]int{},
+		},
+		{
+			s:    "你好，world",
+			want: map[int]int{3: 3},
+		},
+		{
+			s:    "\U00020000\U0002A700中",
+			want: map[int]int{3: 1, 4: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChineseByteSizeHistogram(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChineseByteSizeHistogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseDensityProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		buckets int
+		want    []float64
+	}{
+		{
+			s:       "你好",
+			buckets: 0,
+			want:    nil,
+		},
+		{
+			s:       "",
+			buckets: 2,
+			want:    []float64{0, 0},
+		},
+		{
+			s:       "你好世界hello world",
+			buckets: 2,
+			want:    []float64{0.5, 0},
+		},
+		{
+			s:       "你好ab",
+			buckets: 4,
+			want:    []float64{1, 1, 0, 0},
+		},
+		{
+			s:       "你a",
+			buckets: 3,
+			want:    []float64{1, 0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChineseDensityProfile(tt.s, tt.buckets); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChineseDensityProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMostChinese(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []string
+		wantIndex  int
+		want       string
+	}{
+		{
+			candidates: nil,
+			wantIndex:  -1,
+			want:       "",
+		},
+		{
+			candidates: []string{"hello", "你好o", "你好"},
+			wantIndex:  2,
+			want:       "你好",
+		},
+		{
+			candidates: []string{"abc", "你好", "世界"},
+			wantIndex:  1,
+			want:       "你好",
+		},
+		{
+			candidates: []string{"", "abc"},
+			wantIndex:  0,
+			want:       "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIndex, got := MostChinese(tt.candidates...)
+			if gotIndex != tt.wantIndex || got != tt.want {
+				t.Errorf("MostChinese() = %v, %v, want %v, %v", gotIndex, got, tt.wantIndex, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoverageRatio(t *testing.T) {
+	allowed := map[rune]struct{}{'你': {}, '好': {}, '世': {}}
+	tests := []struct {
+		name string
+		s    string
+		want float64
+	}{
+		{
+			s:    "",
+			want: 0,
+		},
+		{
+			s:    "hello",
+			want: 0,
+		},
+		{
+			s:    "你好，世界！hello",
+			want: 0.75,
+		},
+		{
+			s:    "你好",
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CoverageRatio(tt.s, allowed); got != tt.want {
+				t.Errorf("CoverageRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseRatio(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name            string
+		s               string
+		want            float64
+		wantSimplified  float64
+		wantTraditional float64
+	}{
+		{
+			s: "",
+		},
+		{
+			s: "hello",
+		},
+		{
+			s:               "你好",
+			want:            1,
+			wantSimplified:  1,
+			wantTraditional: 1,
+		},
+		{
+			s:               "国國ab",
+			want:            0.5,
+			wantSimplified:  0.25,
+			wantTraditional: 0.25,
+		},
+		{
+			s:               "大劉說說",
+			want:            1,
+			wantSimplified:  0.25,
+			wantTraditional: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChineseRatio(tt.s); got != tt.want {
+				t.Errorf("ChineseRatio() = %v, want %v", got, tt.want)
+			}
+			if got := SimplifiedChineseRatio(tt.s); got != tt.wantSimplified {
+				t.Errorf("SimplifiedChineseRatio() = %v, want %v", got, tt.wantSimplified)
+			}
+			if got := TraditionalChineseRatio(tt.s); got != tt.wantTraditional {
+				t.Errorf("TraditionalChineseRatio() = %v, want %v", got, tt.wantTraditional)
+			}
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want DetectionStats
+	}{
+		{
+			s:    "",
+			want: DetectionStats{},
+		},
+		{
+			s: "这個人，ok 😀か\xff",
+			want: DetectionStats{
+				TotalRunes:      10,
+				ChineseRunes:    4,
+				SimplifiedOnly:  1,
+				TraditionalOnly: 1,
+				Shared:          1,
+				Punctuation:     1,
+				ASCII:           3,
+				Other:           3,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Stats(tt.s)
+			if got != tt.want {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+			if sum := got.SimplifiedOnly + got.TraditionalOnly + got.Shared + got.Punctuation + got.ASCII + got.Other; sum != got.TotalRunes {
+				t.Errorf("buckets sum to %v, want TotalRunes %v", sum, got.TotalRunes)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package ischinese
+
+// VariantPairs return pairs of simplified and traditional unicode code points which both appear in s,
+// e.g. [国 國] for "国與國", in the order the second character of each pair is found
+func VariantPairs(s string) [][2]rune {
+	var pairs [][2]rune
+	seen := make(map[rune]struct{})
+	found := make(map[[2]rune]struct{})
+	add := func(pair [2]rune) {
+		if _, ok := found[pair]; ok {
+			return
+		}
+		found[pair] = struct{}{}
+		pairs = append(pairs, pair)
+	}
+	for _, r := range s {
+		if simplified := replace2SimplifiedChar(r); simplified != r {
+			if _, ok := seen[simplified]; ok {
+				add([2]rune{simplified, r})
+			}
+		}
+		if traditional := replace2TraditionalChar(r); traditional != r {
+			if _, ok := seen[traditional]; ok {
+				add([2]rune{r, traditional})
+			}
+		}
+		seen[r] = struct{}{}
+	}
+	return pairs
+}
+
+// ContainsVariantPair true if s contains both a character and its simplified or traditional counterpart
+func ContainsVariantPair(s string) bool {
+	return len(VariantPairs(s)) > 0
+}
+
+// ScriptDiff return the Levenshtein distance in unicode code points between a and b after converting both with ToSimplified,
+// so text which differs only by script, e.g. "国家" and "國家", has distance 0 and only genuine edits count.
+// Canonicalization uses ToSimplified, which picks the first simplified variant Unihan lists for each traditional character,
+// other simplified variants of it still count as edits.
+func ScriptDiff(a, b string) int {
+	ra, rb := []rune(ToSimplified(a)), []rune(ToSimplified(b))
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(first int, rest ...int) int {
+	for _, n := range rest {
+		if n < first {
+			first = n
+		}
+	}
+	return first
+}
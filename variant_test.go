@@ -0,0 +1,98 @@
+package ischinese
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVariantPairs(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		s    string
+		want [][2]rune
+	}{
+		{
+			s:    "",
+			want: nil,
+		},
+		{
+			s:    "中国人",
+			want: nil,
+		},
+		{
+			s:    "国與國",
+			want: [][2]rune{{'国', '國'}},
+		},
+		{
+			s:    "國与国，說说說",
+			want: [][2]rune{{'国', '國'}, {'说', '說'}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VariantPairs(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VariantPairs() = %v, want %v", got, tt.want)
+			}
+			if got := ContainsVariantPair(tt.s); got != (len(tt.want) > 0) {
+				t.Errorf("ContainsVariantPair() = %v, want %v", got, len(tt.want) > 0)
+			}
+		})
+	}
+}
+
+func TestScriptDiff(t *testing.T) {
+	requireEmbeddedDictionary(t)
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			a:    "",
+			b:    "",
+			want: 0,
+		},
+		{
+			a:    "国家",
+			b:    "國家",
+			want: 0,
+		},
+		{
+			a:    "喜欢锻炼的人，身体应该比较好",
+			b:    "喜歡鍛煉的人，身體應該比較好",
+			want: 0,
+		},
+		{
+			a:    "国家",
+			b:    "國",
+			want: 1,
+		},
+		{
+			a:    "我爱中国",
+			b:    "我愛美國",
+			want: 1,
+		},
+		{
+			a:    "",
+			b:    "說話",
+			want: 2,
+		},
+		{
+			a:    "kitten",
+			b:    "sitting",
+			want: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScriptDiff(tt.a, tt.b); got != tt.want {
+				t.Errorf("ScriptDiff() = %v, want %v", got, tt.want)
+			}
+			if got := ScriptDiff(tt.b, tt.a); got != tt.want {
+				t.Errorf("ScriptDiff() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
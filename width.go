@@ -0,0 +1,30 @@
+package ischinese
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// DisplayWidth return the number of terminal columns s takes by East Asian Width (UAX #11): wide and fullwidth code points,
+// e.g. CJK ideographs, Chinese punctuation and fullwidth forms, count 2. Nonspacing marks, format and control characters count 0,
+// others count 1, including ambiguous ones such as “ or ·, which East Asian fonts may render 2 wide.
+func DisplayWidth(s string) int {
+	var w int
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+func runeWidth(r rune) int {
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc) {
+		return 0
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
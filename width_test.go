@@ -0,0 +1,55 @@
+package ischinese
+
+import (
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{
+			s:    "",
+			want: 0,
+		},
+		{
+			s:    "你好",
+			want: 4,
+		},
+		{
+			s:    "hi",
+			want: 2,
+		},
+		{
+			s:    "你好，world!",
+			want: 12,
+		},
+		{
+			s:    "ＡＢ１",
+			want: 6,
+		},
+		// halfwidth katakana
+		{
+			s:    "ｶﾅ",
+			want: 2,
+		},
+		{
+			s:    "\U00020000〇",
+			want: 4,
+		},
+		// zero width space and combining caron
+		{
+			s:    "a\u200Bi\u030C",
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisplayWidth(tt.s); got != tt.want {
+				t.Errorf("DisplayWidth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}